@@ -0,0 +1,159 @@
+// Command i18n-xliff exports messages to XLIFF files for translation, and
+// imports translated XLIFF files back into messages files.
+//
+// Exporting writes the source locale's messages, and the target locale's
+// translations of them, as an XLIFF 1.2 or 2.0 file:
+//
+//     i18n-xliff export -rules data/rules -messages data/messages -source en -target fr > fr.xlf
+//
+// Importing writes the translations in an XLIFF file as a YAML messages file,
+// keeping each message's notes and translation state as comments. Targets
+// which still need translating are left out:
+//
+//     i18n-xliff import -o data/messages/fr.yaml fr.xlf
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vube/i18n"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = export(os.Args[2:])
+	case "import":
+		err = importXLIFF(os.Args[2:])
+	default:
+		usage()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "i18n-xliff:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: i18n-xliff export -rules paths -messages paths -source locale -target locale [-version 1.2|2.0] [-o file]")
+	fmt.Fprintln(os.Stderr, "       i18n-xliff import [-o file] [file]")
+	os.Exit(2)
+}
+
+// export writes an XLIFF file with a source and target locale's messages
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	rules := flags.String("rules", "", "comma separated rules paths")
+	messages := flags.String("messages", "", "comma separated messages paths")
+	source := flags.String("source", "en", "source locale")
+	target := flags.String("target", "", "target locale")
+	version := flags.String("version", i18n.XLIFF20, "XLIFF version, 1.2 or 2.0")
+	output := flags.String("o", "", "output file, instead of stdout")
+	flags.Parse(args)
+
+	if *rules == "" || *messages == "" || *target == "" {
+		usage()
+	}
+
+	// locales without messages files of their own, like ones with only
+	// rules, only cause warnings
+	f, errors := i18n.NewTranslatorFactory(strings.Split(*rules, ","), strings.Split(*messages, ","), *source)
+	if err := firstError(errors); err != nil {
+		return err
+	}
+
+	sourceTranslator, errors := f.GetTranslator(*source)
+	if err := firstError(errors); err != nil {
+		return err
+	}
+
+	targetTranslator, errors := f.GetTranslator(*target)
+	if err := firstError(errors); err != nil {
+		return err
+	}
+
+	return writeOutput(*output, func(w io.Writer) error {
+		return i18n.ExportXLIFF(w, sourceTranslator, targetTranslator, *version)
+	})
+}
+
+// firstError returns the first of the errors which isn't only a warning
+func firstError(errors []error) error {
+	for _, err := range errors {
+		if !i18n.IsWarning(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// importXLIFF writes the translations in an XLIFF file as a messages file
+func importXLIFF(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	output := flags.String("o", "", "output messages file, instead of stdout")
+	flags.Parse(args)
+
+	r := io.Reader(os.Stdin)
+	if flags.NArg() > 0 {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	d, err := i18n.ReadXLIFF(r)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, d.WriteMessages)
+}
+
+// writeOutput writes to the output file, or stdout if there isn't one. The
+// file is written to a temporary file which replaces it once it's complete,
+// so a failed write leaves the previous file as it was.
+func writeOutput(output string, write func(io.Writer) error) error {
+	if output == "" {
+		return write(os.Stdout)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(output), "."+filepath.Base(output)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	// temporary files are only readable by their owner, so give the file the
+	// mode of the one it replaces, or the usual mode for a new file
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(output); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), output)
+}
@@ -27,8 +27,8 @@ const (
 // Technically, all a-z,A-Z characters should be treated as if they represent a
 // datetime unit - but not all actually do. Any a-z,A-Z character that is
 // intended to be rendered as a literal a-z,A-Z character should be surrounded
-// by single quotes. There is currently no support for rendering a single quote
-// literal.
+// by single quotes. Two single quotes render a single quote literal, both inside
+// and outside of quoted text - "h 'o''clock'" renders as "3 o'clock".
 const (
 	datetimeFormatUnitEra       = 'G'
 	datetimeFormatUnitYear      = 'y'
@@ -41,9 +41,35 @@ const (
 	datetimeFormatUnitSecond    = 's'
 	datetimeFormatUnitPeriod    = 'a'
 	datetimeForamtUnitQuarter   = 'Q'
+	datetimeFormatUnitQuarter2  = 'q'
 	datetimeFormatUnitTimeZone1 = 'z'
 	datetimeFormatUnitTimeZone2 = 'v'
 
+	datetimeFormatUnitTimeZoneID   = 'V'
+	datetimeFormatUnitTimeZoneGMT  = 'O'
+	datetimeFormatUnitTimeZoneISOZ = 'X'
+	datetimeFormatUnitTimeZoneISO  = 'x'
+	datetimeFormatUnitTimeZoneRFC  = 'Z'
+
+	datetimeFormatUnitExtendedYear        = 'u'
+	datetimeFormatUnitCyclicYear          = 'U'
+	datetimeFormatUnitRelatedYear         = 'r'
+	datetimeFormatUnitWeekYear            = 'Y'
+	datetimeFormatUnitMonthStandAlone     = 'L'
+	datetimeFormatUnitWeekOfYear          = 'w'
+	datetimeFormatUnitWeekOfMonth         = 'W'
+	datetimeFormatUnitDayOfYear           = 'D'
+	datetimeFormatUnitDayOfWeekInMonth    = 'F'
+	datetimeFormatUnitModifiedJulianDay   = 'g'
+	datetimeFormatUnitDayOfWeekLocal      = 'e'
+	datetimeFormatUnitDayOfWeekStandAlone = 'c'
+	datetimeFormatUnitPeriodNoon          = 'b'
+	datetimeFormatUnitPeriodFlexible      = 'B'
+	datetimeFormatUnitHour12From0         = 'K'
+	datetimeFormatUnitHour24From1         = 'k'
+	datetimeFormatUnitFractionalSecond    = 'S'
+	datetimeFormatUnitMillisecondsInDay   = 'A'
+
 	datetimeFormatTimeSeparator = ':'
 	datetimeFormatLiteral       = '\''
 )
@@ -56,6 +82,7 @@ const (
 	datetimeFormatLengthAbbreviated = 3
 	datetimeFormatLengthWide        = 4
 	datetimeFormatLengthNarrow      = 5
+	datetimeFormatLengthShort       = 6
 )
 
 // datetime formats are a sequences off datetime components and string literals
@@ -64,17 +91,6 @@ const (
 	datetimePatternComponentLiteral
 )
 
-// A list of currently unsupported units:
-// These still need to be implemented. For now they are ignored.
-var (
-	datetimeFormatUnitCutset = []rune{
-		datetimeFormatUnitEra,
-		datetimeForamtUnitQuarter,
-		datetimeFormatUnitTimeZone1,
-		datetimeFormatUnitTimeZone2,
-	}
-)
-
 type datetimePatternComponent struct {
 	pattern       string
 	componentType int
@@ -84,6 +100,22 @@ type datetimePatternComponent struct {
 // string. Callers should use a DateFormat, TimeFormat, or DateTimeFormat
 // constant.
 func (t *Translator) FormatDateTime(format int, datetime time.Time) (string, error) {
+	pattern, err := t.dateTimeFormatPattern(format)
+	if err != nil {
+		return "", err
+	}
+
+	parsed, err := t.parseDateTimeFormat(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return t.formatDateTime(datetime, parsed)
+}
+
+// dateTimeFormatPattern returns the locale's pattern for a DateFormat,
+// TimeFormat, or DateTimeFormat constant.
+func (t *Translator) dateTimeFormatPattern(format int) (string, error) {
 	pattern := ""
 	switch format {
 	case DateFormatFull:
@@ -119,9 +151,17 @@ func (t *Translator) FormatDateTime(format int, datetime time.Time) (string, err
 		timePattern := strings.Trim(t.rules.DateTime.Formats.Time.Short, " ,")
 		pattern = getDateTimePattern(t.rules.DateTime.Formats.DateTime.Short, datePattern, timePattern)
 	default:
-		return "", translatorError{message: "unknown datetime format" + pattern[0:1]}
+		return "", translatorError{message: fmt.Sprintf("unknown datetime format: %d", format)}
 	}
 
+	return pattern, nil
+}
+
+// FormatDateTimePattern takes a time struct and a custom LDML datetime
+// pattern, like "EEE, d MMM", and returns a formatted string using the
+// locale's names. Letters which shouldn't be rendered as datetime units need
+// to be surrounded by single quotes.
+func (t *Translator) FormatDateTimePattern(pattern string, datetime time.Time) (string, error) {
 	parsed, err := t.parseDateTimeFormat(pattern)
 	if err != nil {
 		return "", err
@@ -175,35 +215,131 @@ func (t *Translator) formatDateTimeComponent(datetime time.Time, pattern string)
 	case string(datetimeFormatUnitPeriod):
 		return t.formatDateTimeComponentPeriod(datetime, len(pattern))
 	case string(datetimeForamtUnitQuarter):
-		return t.formatDateTimeComponentQuarter(datetime, len(pattern))
+		return t.formatDateTimeComponentQuarter(datetime, len(pattern), false)
+	case string(datetimeFormatUnitQuarter2):
+		return t.formatDateTimeComponentQuarter(datetime, len(pattern), true)
 	case string(datetimeFormatUnitTimeZone1):
-		fallthrough
+		return t.formatDateTimeComponentTimeZoneSpecific(datetime, len(pattern))
 	case string(datetimeFormatUnitTimeZone2):
-		return t.formatDateTimeComponentTimeZone(datetime, len(pattern))
+		return t.formatDateTimeComponentTimeZoneGeneric(datetime, len(pattern))
+	case string(datetimeFormatUnitTimeZoneID):
+		return t.formatDateTimeComponentTimeZoneLocation(datetime, len(pattern))
+	case string(datetimeFormatUnitTimeZoneGMT):
+		return t.formatDateTimeComponentTimeZoneGMT(datetime, len(pattern))
+	case string(datetimeFormatUnitTimeZoneISOZ):
+		return t.formatDateTimeComponentTimeZoneISO(datetime, len(pattern), true)
+	case string(datetimeFormatUnitTimeZoneISO):
+		return t.formatDateTimeComponentTimeZoneISO(datetime, len(pattern), false)
+	case string(datetimeFormatUnitTimeZoneRFC):
+		return t.formatDateTimeComponentTimeZoneRFC(datetime, len(pattern))
+	case string(datetimeFormatUnitExtendedYear), string(datetimeFormatUnitRelatedYear):
+		return zeroPad(datetime.Year(), len(pattern)), nil
+	case string(datetimeFormatUnitCyclicYear):
+		// the gregorian calendar doesn't have cyclic year names, so these are
+		// rendered as numeric years
+		return t.formatDateTimeComponentYear(datetime, len(pattern))
+	case string(datetimeFormatUnitWeekYear):
+		return t.formatDateTimeComponentWeekYear(datetime, len(pattern))
+	case string(datetimeFormatUnitMonthStandAlone):
+		return t.formatDateTimeComponentMonthStandAlone(datetime, len(pattern))
+	case string(datetimeFormatUnitWeekOfYear):
+		return t.formatDateTimeComponentWeekOfYear(datetime, len(pattern))
+	case string(datetimeFormatUnitWeekOfMonth):
+		return t.formatDateTimeComponentWeekOfMonth(datetime, len(pattern))
+	case string(datetimeFormatUnitDayOfYear):
+		return t.formatDateTimeComponentDayOfYear(datetime, len(pattern))
+	case string(datetimeFormatUnitDayOfWeekInMonth):
+		return t.formatDateTimeComponentDayOfWeekInMonth(datetime, len(pattern))
+	case string(datetimeFormatUnitModifiedJulianDay):
+		return t.formatDateTimeComponentModifiedJulianDay(datetime, len(pattern))
+	case string(datetimeFormatUnitDayOfWeekLocal):
+		return t.formatDateTimeComponentDayOfWeekLocal(datetime, len(pattern), false)
+	case string(datetimeFormatUnitDayOfWeekStandAlone):
+		return t.formatDateTimeComponentDayOfWeekLocal(datetime, len(pattern), true)
+	case string(datetimeFormatUnitPeriodNoon):
+		return t.formatDateTimeComponentPeriodNoon(datetime, len(pattern))
+	case string(datetimeFormatUnitPeriodFlexible):
+		return t.formatDateTimeComponentPeriodFlexible(datetime, len(pattern))
+	case string(datetimeFormatUnitHour12From0):
+		return t.formatDateTimeComponentHour12From0(datetime, len(pattern))
+	case string(datetimeFormatUnitHour24From1):
+		return t.formatDateTimeComponentHour24From1(datetime, len(pattern))
+	case string(datetimeFormatUnitFractionalSecond):
+		return t.formatDateTimeComponentFractionalSecond(datetime, len(pattern))
+	case string(datetimeFormatUnitMillisecondsInDay):
+		return t.formatDateTimeComponentMillisecondsInDay(datetime, len(pattern))
 	}
 
 	return "", translatorError{message: "unknown datetime format unit: " + pattern[0:1]}
 }
 
-// formatDateTimeComponentEra renders an era component.
-// TODO: not yet implemented
+// formatDateTimeComponentEra renders an era component - BC for years before
+// year 1, and AD from then on.
 func (t *Translator) formatDateTimeComponentEra(datetime time.Time, length int) (string, error) {
-	return "", nil
+	ad := datetime.Year() > 0
+
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus, datetimeFormatLengthAbbreviated:
+		return t.formatDateTimeComponentEraAbbreviated(ad), nil
+	case datetimeFormatLengthWide:
+		return t.formatDateTimeComponentEraWide(ad), nil
+	case datetimeFormatLengthNarrow:
+		return t.formatDateTimeComponentEraNarrow(ad), nil
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported era: %d", length)}
+}
+
+// formatDateTimeComponentEraAbbreviated renders an abbreviated era component.
+func (t *Translator) formatDateTimeComponentEraAbbreviated(ad bool) string {
+	if ad {
+		return t.rules.DateTime.FormatNames.Eras.Abbreviated.AD
+	}
+
+	return t.rules.DateTime.FormatNames.Eras.Abbreviated.BC
+}
+
+// formatDateTimeComponentEraWide renders a full era component.
+func (t *Translator) formatDateTimeComponentEraWide(ad bool) string {
+	if ad {
+		return t.rules.DateTime.FormatNames.Eras.Wide.AD
+	}
+
+	return t.rules.DateTime.FormatNames.Eras.Wide.BC
+}
+
+// formatDateTimeComponentEraNarrow renders a super-short era component.
+func (t *Translator) formatDateTimeComponentEraNarrow(ad bool) string {
+	if ad {
+		return t.rules.DateTime.FormatNames.Eras.Narrow.AD
+	}
+
+	return t.rules.DateTime.FormatNames.Eras.Narrow.BC
 }
 
-// formatDateTimeComponentYear renders a year component.
+// formatDateTimeComponentYear renders a year component. Years are counted
+// within their era, so year 0 is 1 BC.
 func (t *Translator) formatDateTimeComponentYear(datetime time.Time, length int) (string, error) {
 	year := datetime.Year()
+	if year <= 0 {
+		year = 1 - year
+	}
+
+	return t.formatDateTimeComponentYearNumber(year, length), nil
+}
+
+// formatDateTimeComponentYearNumber renders a year number. A length of 2 is
+// the last 2 digits of the year, and the year is zero-padded to any other
+// length - so "yyy" is "017" for the year 17 and "2017" for 2017.
+func (t *Translator) formatDateTimeComponentYearNumber(year, length int) string {
 	switch length {
 	case datetimeFormatLength1Plus:
-		return t.formatDateTimeComponentYearLengthWide(year), nil
+		return t.formatDateTimeComponentYearLengthWide(year)
 	case datetimeFormatLength2Plus:
-		return t.formatDateTimeComponentYearLength2Plus(year), nil
-	case datetimeFormatLengthWide:
-		return t.formatDateTimeComponentYearLengthWide(year), nil
+		return t.formatDateTimeComponentYearLength2Plus(year)
 	}
 
-	return "", translatorError{message: fmt.Sprintf("unsupported year length: %d", length)}
+	return zeroPad(year, length)
 }
 
 // formatDateTimeComponentYearLength2Plus renders a 2-digit year component.
@@ -360,16 +496,14 @@ func (t *Translator) formatDateTimeComponentMonthNarrow(month int) string {
 // formatDateTimeComponentDayOfWeek renders a day-of-week component.
 func (t *Translator) formatDateTimeComponentDayOfWeek(datetime time.Time, length int) (string, error) {
 	switch length {
-	case datetimeFormatLength1Plus:
-		return t.formatDateTimeComponentDayOfWeekWide(datetime.Weekday()), nil
-	case datetimeFormatLength2Plus:
-		return t.formatDateTimeComponentDayOfWeekShort(datetime.Weekday()), nil
-	case datetimeFormatLengthAbbreviated:
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus, datetimeFormatLengthAbbreviated:
 		return t.formatDateTimeComponentDayOfWeekAbbreviated(datetime.Weekday()), nil
 	case datetimeFormatLengthWide:
 		return t.formatDateTimeComponentDayOfWeekWide(datetime.Weekday()), nil
 	case datetimeFormatLengthNarrow:
 		return t.formatDateTimeComponentDayOfWeekNarrow(datetime.Weekday()), nil
+	case datetimeFormatLengthShort:
+		return t.formatDateTimeComponentDayOfWeekShort(datetime.Weekday()), nil
 	}
 
 	return "", translatorError{message: fmt.Sprintf("unsupported year day-of-week: %d", length)}
@@ -467,7 +601,7 @@ func (t *Translator) formatDateTimeComponentDayOfWeekNarrow(dayOfWeek time.Weekd
 	return ""
 }
 
-// formatDateTimeComponentDay renders a day-of-year component.
+// formatDateTimeComponentDay renders a day-of-month component.
 func (t *Translator) formatDateTimeComponentDay(datetime time.Time, length int) (string, error) {
 	day := datetime.Day()
 
@@ -481,7 +615,7 @@ func (t *Translator) formatDateTimeComponentDay(datetime time.Time, length int)
 		return fmt.Sprintf("%d", day), nil
 	}
 
-	return "", translatorError{message: fmt.Sprintf("unsupported day-of-year: %d", length)}
+	return "", translatorError{message: fmt.Sprintf("unsupported day-of-month: %d", length)}
 }
 
 // formatDateTimeComponentHour12 renders an hour-component using a 12-hour
@@ -490,6 +624,8 @@ func (t *Translator) formatDateTimeComponentHour12(datetime time.Time, length in
 	hour := datetime.Hour()
 	if hour > 12 {
 		hour = hour - 12
+	} else if hour == 0 {
+		hour = 12
 	}
 
 	switch length {
@@ -562,9 +698,7 @@ func (t *Translator) formatDateTimeComponentPeriod(datetime time.Time, length in
 	hour := datetime.Hour()
 
 	switch length {
-	case datetimeFormatLength1Plus:
-		return t.formatDateTimeComponentPeriodWide(hour), nil
-	case datetimeFormatLengthAbbreviated:
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus, datetimeFormatLengthAbbreviated:
 		return t.formatDateTimeComponentPeriodAbbreviated(hour), nil
 	case datetimeFormatLengthWide:
 		return t.formatDateTimeComponentPeriodWide(hour), nil
@@ -609,15 +743,505 @@ func (t *Translator) formatDateTimeComponentPeriodNarrow(hour int) string {
 //  - Q2: Apr-Jun
 //  - Q3: Jul-Sep
 //  - Q4: Oct-Dec
-// TODO: not yet implemented
-func (t *Translator) formatDateTimeComponentQuarter(datetime time.Time, length int) (string, error) {
-	return "", nil
+// Stand-alone quarters (q) use the locale's stand-alone quarter names, which
+// some languages use when the quarter isn't part of a date. Quarters without a
+// stand-alone name use the format name (Q).
+func (t *Translator) formatDateTimeComponentQuarter(datetime time.Time, length int, standAlone bool) (string, error) {
+	quarter := (int(datetime.Month())-1)/3 + 1
+
+	switch length {
+	case datetimeFormatLength1Plus:
+		return fmt.Sprintf("%d", quarter), nil
+	case datetimeFormatLength2Plus:
+		return fmt.Sprintf("0%d", quarter), nil
+	case datetimeFormatLengthAbbreviated:
+		return t.formatDateTimeComponentQuarterAbbreviated(quarter, standAlone), nil
+	case datetimeFormatLengthWide:
+		return t.formatDateTimeComponentQuarterWide(quarter, standAlone), nil
+	case datetimeFormatLengthNarrow:
+		return t.formatDateTimeComponentQuarterNarrow(quarter, standAlone), nil
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported quarter: %d", length)}
+}
+
+// formatDateTimeComponentQuarterAbbreviated renders an abbreviated quarter
+// component.
+func (t *Translator) formatDateTimeComponentQuarterAbbreviated(quarter int, standAlone bool) string {
+	standAloneNames := t.rules.DateTime.StandAloneNames.Quarters.Abbreviated
+	names := t.rules.DateTime.FormatNames.Quarters.Abbreviated
+	if standAlone {
+		names.Quarter1 = stringMerge(names.Quarter1, standAloneNames.Quarter1)
+		names.Quarter2 = stringMerge(names.Quarter2, standAloneNames.Quarter2)
+		names.Quarter3 = stringMerge(names.Quarter3, standAloneNames.Quarter3)
+		names.Quarter4 = stringMerge(names.Quarter4, standAloneNames.Quarter4)
+	}
+
+	return quarterName(quarter, names.Quarter1, names.Quarter2, names.Quarter3, names.Quarter4)
+}
+
+// formatDateTimeComponentQuarterWide renders a full quarter component.
+func (t *Translator) formatDateTimeComponentQuarterWide(quarter int, standAlone bool) string {
+	standAloneNames := t.rules.DateTime.StandAloneNames.Quarters.Wide
+	names := t.rules.DateTime.FormatNames.Quarters.Wide
+	if standAlone {
+		names.Quarter1 = stringMerge(names.Quarter1, standAloneNames.Quarter1)
+		names.Quarter2 = stringMerge(names.Quarter2, standAloneNames.Quarter2)
+		names.Quarter3 = stringMerge(names.Quarter3, standAloneNames.Quarter3)
+		names.Quarter4 = stringMerge(names.Quarter4, standAloneNames.Quarter4)
+	}
+
+	return quarterName(quarter, names.Quarter1, names.Quarter2, names.Quarter3, names.Quarter4)
+}
+
+// formatDateTimeComponentQuarterNarrow renders a super-short quarter
+// component.
+func (t *Translator) formatDateTimeComponentQuarterNarrow(quarter int, standAlone bool) string {
+	standAloneNames := t.rules.DateTime.StandAloneNames.Quarters.Narrow
+	names := t.rules.DateTime.FormatNames.Quarters.Narrow
+	if standAlone {
+		names.Quarter1 = stringMerge(names.Quarter1, standAloneNames.Quarter1)
+		names.Quarter2 = stringMerge(names.Quarter2, standAloneNames.Quarter2)
+		names.Quarter3 = stringMerge(names.Quarter3, standAloneNames.Quarter3)
+		names.Quarter4 = stringMerge(names.Quarter4, standAloneNames.Quarter4)
+	}
+
+	return quarterName(quarter, names.Quarter1, names.Quarter2, names.Quarter3, names.Quarter4)
+}
+
+// quarterName returns the name of a quarter from 1 to 4
+func quarterName(quarter int, quarter1, quarter2, quarter3, quarter4 string) string {
+	switch quarter {
+	case 1:
+		return quarter1
+	case 2:
+		return quarter2
+	case 3:
+		return quarter3
+	case 4:
+		return quarter4
+	}
+
+	return ""
+}
+
+// formatDateTimeComponentWeekYear renders the year of the week a date is in,
+// which for dates in the first or last days of a year can be the year before
+// or after the date's own year.
+func (t *Translator) formatDateTimeComponentWeekYear(datetime time.Time, length int) (string, error) {
+	year, _ := t.weekOfYear(datetime)
+	if year <= 0 {
+		year = 1 - year
+	}
+
+	return t.formatDateTimeComponentYearNumber(year, length), nil
+}
+
+// formatDateTimeComponentMonthStandAlone renders a stand-alone month
+// component, which some languages use when the month isn't part of a date -
+// in Russian, for example, "январь" rather than the "января" of "2 января".
+// Months without a stand-alone name use the format name (M).
+func (t *Translator) formatDateTimeComponentMonthStandAlone(datetime time.Time, length int) (string, error) {
+	month := int(datetime.Month())
+	names := t.rules.DateTime.StandAloneNames.Months.Abbreviated
+	name := ""
+
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus:
+		return t.formatDateTimeComponentMonth(datetime, length)
+	case datetimeFormatLengthAbbreviated:
+		name = t.formatDateTimeComponentMonthAbbreviated(month)
+	case datetimeFormatLengthWide:
+		names = t.rules.DateTime.StandAloneNames.Months.Wide
+		name = t.formatDateTimeComponentMonthWide(month)
+	case datetimeFormatLengthNarrow:
+		names = t.rules.DateTime.StandAloneNames.Months.Narrow
+		name = t.formatDateTimeComponentMonthNarrow(month)
+	default:
+		return "", translatorError{message: fmt.Sprintf("unsupported stand-alone month length: %d", length)}
+	}
+
+	switch month {
+	case 1:
+		name = stringMerge(name, names.Month1)
+	case 2:
+		name = stringMerge(name, names.Month2)
+	case 3:
+		name = stringMerge(name, names.Month3)
+	case 4:
+		name = stringMerge(name, names.Month4)
+	case 5:
+		name = stringMerge(name, names.Month5)
+	case 6:
+		name = stringMerge(name, names.Month6)
+	case 7:
+		name = stringMerge(name, names.Month7)
+	case 8:
+		name = stringMerge(name, names.Month8)
+	case 9:
+		name = stringMerge(name, names.Month9)
+	case 10:
+		name = stringMerge(name, names.Month10)
+	case 11:
+		name = stringMerge(name, names.Month11)
+	case 12:
+		name = stringMerge(name, names.Month12)
+	}
+
+	return name, nil
+}
+
+// formatDateTimeComponentWeekOfYear renders a week-of-year component, using
+// the locale's first day of the week and the minimal number of days the first
+// week of a year must have.
+func (t *Translator) formatDateTimeComponentWeekOfYear(datetime time.Time, length int) (string, error) {
+	_, week := t.weekOfYear(datetime)
+
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus:
+		return zeroPad(week, length), nil
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported week-of-year: %d", length)}
+}
+
+// formatDateTimeComponentWeekOfMonth renders a week-of-month component. Days
+// before the month's first full week are in week 0.
+func (t *Translator) formatDateTimeComponentWeekOfMonth(datetime time.Time, length int) (string, error) {
+	if length != datetimeFormatLength1Plus {
+		return "", translatorError{message: fmt.Sprintf("unsupported week-of-month: %d", length)}
+	}
+
+	return fmt.Sprintf("%d", t.weekNumber(datetime.Day(), datetime.Weekday())), nil
+}
+
+// formatDateTimeComponentDayOfYear renders a day-of-year component.
+func (t *Translator) formatDateTimeComponentDayOfYear(datetime time.Time, length int) (string, error) {
+	if length > datetimeFormatLengthAbbreviated {
+		return "", translatorError{message: fmt.Sprintf("unsupported day-of-year: %d", length)}
+	}
+
+	return zeroPad(datetime.YearDay(), length), nil
+}
+
+// formatDateTimeComponentDayOfWeekInMonth renders which occurrence of its day
+// of the week in the month a date is - 2 for the second Wednesday in July.
+func (t *Translator) formatDateTimeComponentDayOfWeekInMonth(datetime time.Time, length int) (string, error) {
+	if length != datetimeFormatLength1Plus {
+		return "", translatorError{message: fmt.Sprintf("unsupported day-of-week-in-month: %d", length)}
+	}
+
+	return fmt.Sprintf("%d", (datetime.Day()-1)/7+1), nil
+}
+
+// formatDateTimeComponentModifiedJulianDay renders the modified julian day -
+// the number of days since November 17th 1858 - zero-padded to the length.
+func (t *Translator) formatDateTimeComponentModifiedJulianDay(datetime time.Time, length int) (string, error) {
+	date := time.Date(datetime.Year(), datetime.Month(), datetime.Day(), 0, 0, 0, 0, time.UTC)
+
+	// 1970-01-01 is modified julian day 40587
+	return zeroPad(int(date.Unix()/86400)+40587, length), nil
+}
+
+// formatDateTimeComponentDayOfWeekLocal renders a local day-of-week
+// component. Numeric days count from the locale's first day of the week, so
+// Monday is 2 where weeks start on Sunday, and 1 where they start on Monday.
+// Longer lengths are day names, which are stand-alone names for "c".
+func (t *Translator) formatDateTimeComponentDayOfWeekLocal(datetime time.Time, length int, standAlone bool) (string, error) {
+	day := (int(datetime.Weekday())-int(t.firstDayOfWeek())+7)%7 + 1
+
+	switch {
+	case length == datetimeFormatLength1Plus || (standAlone && length == datetimeFormatLength2Plus):
+		return fmt.Sprintf("%d", day), nil
+	case length == datetimeFormatLength2Plus:
+		return zeroPad(day, length), nil
+	case standAlone:
+		return t.formatDateTimeComponentDayOfWeekStandAlone(datetime, length)
+	}
+
+	return t.formatDateTimeComponentDayOfWeek(datetime, length)
+}
+
+// formatDateTimeComponentDayOfWeekStandAlone renders a stand-alone
+// day-of-week name. Days without a stand-alone name use the format name (E).
+func (t *Translator) formatDateTimeComponentDayOfWeekStandAlone(datetime time.Time, length int) (string, error) {
+	names := t.rules.DateTime.StandAloneNames.Days.Abbreviated
+	switch length {
+	case datetimeFormatLengthWide:
+		names = t.rules.DateTime.StandAloneNames.Days.Wide
+	case datetimeFormatLengthNarrow:
+		names = t.rules.DateTime.StandAloneNames.Days.Narrow
+	case datetimeFormatLengthShort:
+		names = t.rules.DateTime.StandAloneNames.Days.Short
+	}
+
+	name, err := t.formatDateTimeComponentDayOfWeek(datetime, length)
+	if err != nil {
+		return "", err
+	}
+
+	switch datetime.Weekday() {
+	case time.Sunday:
+		name = stringMerge(name, names.Sun)
+	case time.Monday:
+		name = stringMerge(name, names.Mon)
+	case time.Tuesday:
+		name = stringMerge(name, names.Tue)
+	case time.Wednesday:
+		name = stringMerge(name, names.Wed)
+	case time.Thursday:
+		name = stringMerge(name, names.Thu)
+	case time.Friday:
+		name = stringMerge(name, names.Fri)
+	case time.Saturday:
+		name = stringMerge(name, names.Sat)
+	}
+
+	return name, nil
+}
+
+// formatDateTimeComponentPeriodNoon renders a period component which is
+// "noon" or "midnight" at exactly those times, if the locale has names for
+// them, and AM/PM otherwise.
+func (t *Translator) formatDateTimeComponentPeriodNoon(datetime time.Time, length int) (string, error) {
+	if datetime.Minute() == 0 && datetime.Second() == 0 {
+		period := ""
+		switch datetime.Hour() {
+		case 0:
+			period = "midnight"
+		case 12:
+			period = "noon"
+		}
+
+		if name := t.dayPeriodName(period, length); name != "" {
+			return name, nil
+		}
+	}
+
+	return t.formatDateTimeComponentPeriod(datetime, length)
+}
+
+// formatDateTimeComponentPeriodFlexible renders a flexible day period
+// component, like "in the evening", using the locale's day period rules.
+// Locales without day periods use "noon"/"midnight" and AM/PM instead.
+func (t *Translator) formatDateTimeComponentPeriodFlexible(datetime time.Time, length int) (string, error) {
+	if name := t.dayPeriodName(t.dayPeriod(datetime), length); name != "" {
+		return name, nil
+	}
+
+	return t.formatDateTimeComponentPeriodNoon(datetime, length)
+}
+
+// formatDateTimeComponentHour12From0 renders an hour-component using a
+// 12-hour clock which counts from 0 to 11.
+func (t *Translator) formatDateTimeComponentHour12From0(datetime time.Time, length int) (string, error) {
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus:
+		return zeroPad(datetime.Hour()%12, length), nil
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported hour-12 from 0: %d", length)}
+}
+
+// formatDateTimeComponentHour24From1 renders an hour-component using a
+// 24-hour clock which counts from 1 to 24.
+func (t *Translator) formatDateTimeComponentHour24From1(datetime time.Time, length int) (string, error) {
+	hour := datetime.Hour()
+	if hour == 0 {
+		hour = 24
+	}
+
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus:
+		return zeroPad(hour, length), nil
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported hour-24 from 1: %d", length)}
+}
+
+// formatDateTimeComponentFractionalSecond renders fractions of a second,
+// truncated to the length - "SSS" is milliseconds.
+func (t *Translator) formatDateTimeComponentFractionalSecond(datetime time.Time, length int) (string, error) {
+	fraction := fmt.Sprintf("%09d", datetime.Nanosecond())
+	if length <= len(fraction) {
+		return fraction[:length], nil
+	}
+
+	return fraction + strings.Repeat("0", length-len(fraction)), nil
+}
+
+// formatDateTimeComponentMillisecondsInDay renders the number of milliseconds
+// since midnight.
+func (t *Translator) formatDateTimeComponentMillisecondsInDay(datetime time.Time, length int) (string, error) {
+	seconds := datetime.Hour()*3600 + datetime.Minute()*60 + datetime.Second()
+	return zeroPad(seconds*1000+datetime.Nanosecond()/1000000, length), nil
+}
+
+// dayPeriodName returns the locale's name for a day period, like "noon" or
+// "morning1", in the width for the pattern length, or "" if it doesn't have
+// one.
+func (t *Translator) dayPeriodName(period string, length int) string {
+	names := t.rules.DateTime.FormatNames.Periods.Abbreviated
+	switch length {
+	case datetimeFormatLength1Plus, datetimeFormatLength2Plus, datetimeFormatLengthAbbreviated:
+	case datetimeFormatLengthWide:
+		names = t.rules.DateTime.FormatNames.Periods.Wide
+	case datetimeFormatLengthNarrow:
+		names = t.rules.DateTime.FormatNames.Periods.Narrow
+	default:
+		return ""
+	}
+
+	switch period {
+	case "midnight":
+		return names.Midnight
+	case "noon":
+		return names.Noon
+	case "morning1":
+		return names.Morning1
+	case "morning2":
+		return names.Morning2
+	case "afternoon1":
+		return names.Afternoon1
+	case "afternoon2":
+		return names.Afternoon2
+	case "evening1":
+		return names.Evening1
+	case "evening2":
+		return names.Evening2
+	case "night1":
+		return names.Night1
+	case "night2":
+		return names.Night2
+	}
+
+	return ""
+}
+
+// dayPeriod returns the locale's day period for a time, or "" if the locale
+// doesn't have day period rules. Rules are either a time the period is at,
+// like "12:00" for noon, or the time the period starts and the time it ends
+// before, like "18:00-21:00". Periods which are at a time only apply at
+// exactly that time.
+func (t *Translator) dayPeriod(datetime time.Time) string {
+	minutes := datetime.Hour()*60 + datetime.Minute()
+	exact := datetime.Second() == 0 && datetime.Nanosecond() == 0
+
+	period := ""
+	for name, rule := range t.rules.DateTime.DayPeriodRules {
+		at, from, before := "", "", ""
+		if dash := strings.Index(rule, "-"); dash != -1 {
+			from, before = rule[:dash], rule[dash+1:]
+		} else {
+			at = rule
+		}
+
+		switch {
+		case at != "":
+			if exact && minutes == dayPeriodMinutes(at) {
+				return name
+			}
+		case inDayPeriod(minutes, from, before):
+			period = name
+		}
+	}
+
+	return period
+}
+
+// inDayPeriod returns whether a number of minutes since midnight is in the
+// day period starting at from and ending before before. Periods can cross
+// midnight, like "21:00-06:00".
+func inDayPeriod(minutes int, from, before string) bool {
+	if dayPeriodMinutes(from) < dayPeriodMinutes(before) {
+		return minutes >= dayPeriodMinutes(from) && minutes < dayPeriodMinutes(before)
+	}
+
+	return minutes >= dayPeriodMinutes(from) || minutes < dayPeriodMinutes(before)
+}
+
+// dayPeriodMinutes returns the number of minutes since midnight of a day
+// period rule time, like "18:00".
+func dayPeriodMinutes(rule string) int {
+	hour, minute := 0, 0
+	fmt.Sscanf(rule, "%d:%d", &hour, &minute)
+	return hour*60 + minute
+}
+
+// firstDayOfWeek returns the locale's first day of the week, which is Monday
+// if the locale doesn't have week rules.
+func (t *Translator) firstDayOfWeek() time.Weekday {
+	switch t.rules.DateTime.Week.FirstDay {
+	case "sun":
+		return time.Sunday
+	case "tue":
+		return time.Tuesday
+	case "wed":
+		return time.Wednesday
+	case "thu":
+		return time.Thursday
+	case "fri":
+		return time.Friday
+	case "sat":
+		return time.Saturday
+	}
+
+	return time.Monday
 }
 
-// formatDateTimeComponentTimeZone renders a time zone component.
-// TODO: this has not yet been implemented
-func (t *Translator) formatDateTimeComponentTimeZone(datetime time.Time, length int) (string, error) {
-	return "", nil
+// weekNumber returns the week of a year or month a day is in, given the day's
+// number in the year or month and its day of the week. The first week is the
+// first one with at least the locale's minimal number of days in the year or
+// month, and days before it are in week 0.
+func (t *Translator) weekNumber(day int, weekday time.Weekday) int {
+	minDays := t.rules.DateTime.Week.MinDays
+	if minDays < 1 {
+		minDays = 1
+	}
+
+	// how many days into its week the first day of the year or month is
+	offset := ((int(weekday)-int(t.firstDayOfWeek())-(day-1))%7 + 7) % 7
+
+	start := 1 - offset
+	if 7-offset < minDays {
+		start += 7
+	}
+
+	if day < start {
+		return 0
+	}
+
+	return (day-start)/7 + 1
+}
+
+// weekOfYear returns the week of the year a date is in, and the year that
+// week belongs to. Days before a year's first week are in the last week of
+// the year before, and days at the end of a year may be in the first week of
+// the next one.
+func (t *Translator) weekOfYear(datetime time.Time) (year, week int) {
+	year = datetime.Year()
+	week = t.weekNumber(datetime.YearDay(), datetime.Weekday())
+
+	if week == 0 {
+		lastDay := time.Date(year, time.January, 0, 0, 0, 0, 0, time.UTC)
+		return year - 1, t.weekNumber(lastDay.YearDay(), lastDay.Weekday())
+	}
+
+	daysInYear := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	if t.weekNumber(datetime.YearDay()-daysInYear, datetime.Weekday()) == 1 {
+		return year + 1, 1
+	}
+
+	return year, week
+}
+
+// zeroPad renders a number with at least length digits.
+func zeroPad(number, length int) string {
+	if number < 0 {
+		return "-" + zeroPad(-number, length)
+	}
+
+	return fmt.Sprintf("%0*d", length, number)
 }
 
 // parseDateTimeFormat takes a format pattern string and returns a sequence of
@@ -630,41 +1254,49 @@ func (t *Translator) parseDateTimeFormat(pattern string) ([]*datetimePatternComp
 	for i := 0; i < len(pattern); {
 		char := pattern[i : i+1]
 
-		skip := false
-		// for units we don't support yet, just skip over them
-		for _, r := range datetimeFormatUnitCutset {
-			if char == string(r) {
-				skip = true
-				break
-			}
-		}
-
-		if skip {
-			i++
-			continue
-		}
-
 		if char == string(datetimeFormatLiteral) {
-			// find the next single quote
-			// create a literal out of everything between the quotes
-			// and set i to the position after the second quote
-
-			if i == len(pattern)-1 {
-				return []*datetimePatternComponent{}, translatorError{message: "malformed datetime format"}
+			// two single quotes are a single quote literal
+			if strings.HasPrefix(pattern[i+1:], string(datetimeFormatLiteral)) {
+				component := &datetimePatternComponent{
+					pattern:       string(datetimeFormatLiteral),
+					componentType: datetimePatternComponentLiteral,
+				}
+
+				format = append(format, component)
+				i += 2
+				continue
 			}
 
-			nextQuote := strings.Index(pattern[i+1:], string(datetimeFormatLiteral))
-			if nextQuote == -1 {
-				return []*datetimePatternComponent{}, translatorError{message: "malformed datetime format"}
+			// find the closing single quote, skipping over any pairs of
+			// single quotes, which are single quote literals
+			// create a literal out of everything between the quotes
+			// and set i to the position after the closing quote
+			literal := ""
+			end := i + 1
+			for {
+				nextQuote := strings.Index(pattern[end:], string(datetimeFormatLiteral))
+				if nextQuote == -1 {
+					return []*datetimePatternComponent{}, translatorError{message: "malformed datetime format"}
+				}
+
+				literal += pattern[end : end+nextQuote]
+				end += nextQuote + 1
+
+				if !strings.HasPrefix(pattern[end:], string(datetimeFormatLiteral)) {
+					break
+				}
+
+				literal += string(datetimeFormatLiteral)
+				end++
 			}
 
 			component := &datetimePatternComponent{
-				pattern:       pattern[i+1 : nextQuote+i+1],
+				pattern:       literal,
 				componentType: datetimePatternComponentLiteral,
 			}
 
 			format = append(format, component)
-			i = nextQuote + i + 2
+			i = end
 			continue
 
 		}
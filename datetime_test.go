@@ -66,12 +66,12 @@ func (s *MySuite) TestFormatDateTime(c *C) {
 	c.Check(dLong, Equals, "January 2, 2006")
 	c.Check(dMedium, Equals, "Jan 2, 2006")
 	c.Check(dShort, Equals, "1/2/06")
-	c.Check(tFull, Equals, "3:04:05 PM")
-	c.Check(tLong, Equals, "3:04:05 PM")
+	c.Check(tFull, Equals, "3:04:05 PM Coordinated Universal Time")
+	c.Check(tLong, Equals, "3:04:05 PM UTC")
 	c.Check(tMedium, Equals, "3:04:05 PM")
 	c.Check(tShort, Equals, "3:04 PM")
-	c.Check(dtFull, Equals, "Monday, January 2, 2006 at 3:04:05 PM")
-	c.Check(dtLong, Equals, "January 2, 2006 at 3:04:05 PM")
+	c.Check(dtFull, Equals, "Monday, January 2, 2006 at 3:04:05 PM Coordinated Universal Time")
+	c.Check(dtLong, Equals, "January 2, 2006 at 3:04:05 PM UTC")
 	c.Check(dtMedium, Equals, "Jan 2, 2006, 3:04:05 PM")
 	c.Check(dtShort, Equals, "1/2/06, 3:04 PM")
 
@@ -294,7 +294,7 @@ func (s *MySuite) TestFormatDateTime(c *C) {
 
 	// test the private method
 	checkAll := "G y yy yyyy M MM MMM MMMM MMMMM E EE EEE EEEE EEEEE d dd h hh H HH m mm s ss a aaa aaaa aaaaa Q z v 'literal':'literal'   ,   "
-	shouldMatch := "2006 06 2006 1 01 Jan January J Monday Mo Mon Monday M 2 02 3 03 15 15 4 04 5 05 PM PM PM p    literal#literal"
+	shouldMatch := "AD 2006 06 2006 1 01 Jan January J Mon Mon Mon Monday M 2 02 3 03 15 15 4 04 5 05 PM PM PM p 1 UTC UTC literal#literal"
 	separator := tEn.rules.DateTime.TimeSeparator
 	tEn.rules.DateTime.TimeSeparator = "#"
 	patternToCheckEverything, _ := tEn.parseDateTimeFormat(checkAll)
@@ -325,7 +325,7 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 
 	str, err := tEn.formatDateTimeComponent(datetime, "G")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "")
+	c.Check(str, Equals, "AD")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "y")
 	c.Check(err, IsNil)
@@ -339,8 +339,9 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 	c.Check(err, IsNil)
 	c.Check(str, Equals, "2006")
 
-	_, err = tEn.formatDateTimeComponent(datetime, "yyyyyy")
-	c.Check(err, NotNil)
+	str, err = tEn.formatDateTimeComponent(datetime, "yyyyyy")
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "002006")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "M")
 	c.Check(err, IsNil)
@@ -367,11 +368,11 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 
 	str, err = tEn.formatDateTimeComponent(datetime, "E")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "Monday")
+	c.Check(str, Equals, "Mon")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "EE")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "Mo")
+	c.Check(str, Equals, "Mon")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "EEE")
 	c.Check(err, IsNil)
@@ -385,7 +386,11 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 	c.Check(err, IsNil)
 	c.Check(str, Equals, "M")
 
-	_, err = tEn.formatDateTimeComponent(datetime, "EEEEEE")
+	str, err = tEn.formatDateTimeComponent(datetime, "EEEEEE")
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "Mo")
+
+	_, err = tEn.formatDateTimeComponent(datetime, "EEEEEEE")
 	c.Check(err, NotNil)
 
 	str, err = tEn.formatDateTimeComponent(datetime, "d")
@@ -451,7 +456,7 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 	tEn.rules.DateTime.FormatNames.Periods.Wide.PM = "wide"
 	str, err = tEn.formatDateTimeComponent(datetime, "a")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "wide")
+	c.Check(str, Equals, "abbr")
 	str, err = tEn.formatDateTimeComponent(datetime, "aaa")
 	c.Check(err, IsNil)
 	c.Check(str, Equals, "abbr")
@@ -470,15 +475,15 @@ func (s *MySuite) TestFormatDateTimeComponent(c *C) {
 
 	str, err = tEn.formatDateTimeComponent(datetime, "Q")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "")
+	c.Check(str, Equals, "1")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "z")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "")
+	c.Check(str, Equals, "UTC")
 
 	str, err = tEn.formatDateTimeComponent(datetime, "v")
 	c.Check(err, IsNil)
-	c.Check(str, Equals, "")
+	c.Check(str, Equals, "UTC")
 
 	_, err = tEn.formatDateTimeComponent(datetime, "%")
 	c.Check(err, NotNil)
@@ -501,129 +506,137 @@ func (s *MySuite) TestParseDateTimeFormat(c *C) {
 	patternToCheckEverything, err := tEn.parseDateTimeFormat(checkAll)
 
 	c.Check(err, IsNil)
-	c.Check(patternToCheckEverything, HasLen, 61)
-	c.Check(patternToCheckEverything[0].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[0].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[1].pattern, Equals, "y")
-	c.Check(patternToCheckEverything[1].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[2].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[2].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[3].pattern, Equals, "yy")
-	c.Check(patternToCheckEverything[3].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[4].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[4].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[5].pattern, Equals, "yyyy")
-	c.Check(patternToCheckEverything[5].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[6].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[6].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[7].pattern, Equals, "M")
-	c.Check(patternToCheckEverything[7].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[8].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[8].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[9].pattern, Equals, "MM")
-	c.Check(patternToCheckEverything[9].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[10].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[10].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[11].pattern, Equals, "MMM")
-	c.Check(patternToCheckEverything[11].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[12].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[12].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[13].pattern, Equals, "MMMM")
-	c.Check(patternToCheckEverything[13].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[14].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[14].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[15].pattern, Equals, "MMMMM")
-	c.Check(patternToCheckEverything[15].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[16].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[16].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[17].pattern, Equals, "E")
-	c.Check(patternToCheckEverything[17].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[18].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[18].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[19].pattern, Equals, "EE")
-	c.Check(patternToCheckEverything[19].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[20].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[20].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[21].pattern, Equals, "EEE")
-	c.Check(patternToCheckEverything[21].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[22].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[22].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[23].pattern, Equals, "EEEE")
-	c.Check(patternToCheckEverything[23].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[24].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[24].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[25].pattern, Equals, "EEEEE")
-	c.Check(patternToCheckEverything[25].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[26].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[26].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[27].pattern, Equals, "d")
-	c.Check(patternToCheckEverything[27].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[28].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[28].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[29].pattern, Equals, "dd")
-	c.Check(patternToCheckEverything[29].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[30].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[30].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[31].pattern, Equals, "h")
-	c.Check(patternToCheckEverything[31].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[32].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[32].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[33].pattern, Equals, "hh")
-	c.Check(patternToCheckEverything[33].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[34].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[34].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[35].pattern, Equals, "H")
-	c.Check(patternToCheckEverything[35].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[36].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[36].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[37].pattern, Equals, "HH")
-	c.Check(patternToCheckEverything[37].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[38].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[38].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[39].pattern, Equals, "m")
-	c.Check(patternToCheckEverything[39].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[40].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[40].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[41].pattern, Equals, "mm")
-	c.Check(patternToCheckEverything[41].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[42].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[42].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[43].pattern, Equals, "s")
-	c.Check(patternToCheckEverything[43].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[44].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[44].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[45].pattern, Equals, "ss")
-	c.Check(patternToCheckEverything[45].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[46].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[46].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[47].pattern, Equals, "a")
-	c.Check(patternToCheckEverything[47].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[48].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[48].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[49].pattern, Equals, "aaa")
-	c.Check(patternToCheckEverything[49].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[50].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[50].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[51].pattern, Equals, "aaaa")
-	c.Check(patternToCheckEverything[51].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[52].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[52].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[53].pattern, Equals, "aaaaa")
-	c.Check(patternToCheckEverything[53].componentType, Equals, datetimePatternComponentUnit)
-	c.Check(patternToCheckEverything[54].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[54].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything, HasLen, 65)
+	c.Check(patternToCheckEverything[0].pattern, Equals, "G")
+	c.Check(patternToCheckEverything[0].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[1].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[1].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[2].pattern, Equals, "y")
+	c.Check(patternToCheckEverything[2].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[3].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[3].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[4].pattern, Equals, "yy")
+	c.Check(patternToCheckEverything[4].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[5].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[5].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[6].pattern, Equals, "yyyy")
+	c.Check(patternToCheckEverything[6].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[7].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[7].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[8].pattern, Equals, "M")
+	c.Check(patternToCheckEverything[8].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[9].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[9].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[10].pattern, Equals, "MM")
+	c.Check(patternToCheckEverything[10].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[11].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[11].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[12].pattern, Equals, "MMM")
+	c.Check(patternToCheckEverything[12].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[13].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[13].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[14].pattern, Equals, "MMMM")
+	c.Check(patternToCheckEverything[14].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[15].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[15].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[16].pattern, Equals, "MMMMM")
+	c.Check(patternToCheckEverything[16].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[17].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[17].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[18].pattern, Equals, "E")
+	c.Check(patternToCheckEverything[18].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[19].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[19].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[20].pattern, Equals, "EE")
+	c.Check(patternToCheckEverything[20].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[21].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[21].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[22].pattern, Equals, "EEE")
+	c.Check(patternToCheckEverything[22].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[23].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[23].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[24].pattern, Equals, "EEEE")
+	c.Check(patternToCheckEverything[24].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[25].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[25].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[26].pattern, Equals, "EEEEE")
+	c.Check(patternToCheckEverything[26].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[27].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[27].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[28].pattern, Equals, "d")
+	c.Check(patternToCheckEverything[28].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[29].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[29].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[30].pattern, Equals, "dd")
+	c.Check(patternToCheckEverything[30].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[31].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[31].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[32].pattern, Equals, "h")
+	c.Check(patternToCheckEverything[32].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[33].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[33].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[34].pattern, Equals, "hh")
+	c.Check(patternToCheckEverything[34].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[35].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[35].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[36].pattern, Equals, "H")
+	c.Check(patternToCheckEverything[36].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[37].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[37].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[38].pattern, Equals, "HH")
+	c.Check(patternToCheckEverything[38].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[39].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[39].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[40].pattern, Equals, "m")
+	c.Check(patternToCheckEverything[40].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[41].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[41].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[42].pattern, Equals, "mm")
+	c.Check(patternToCheckEverything[42].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[43].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[43].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[44].pattern, Equals, "s")
+	c.Check(patternToCheckEverything[44].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[45].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[45].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[46].pattern, Equals, "ss")
+	c.Check(patternToCheckEverything[46].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[47].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[47].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[48].pattern, Equals, "a")
+	c.Check(patternToCheckEverything[48].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[49].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[49].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[50].pattern, Equals, "aaa")
+	c.Check(patternToCheckEverything[50].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[51].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[51].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[52].pattern, Equals, "aaaa")
+	c.Check(patternToCheckEverything[52].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[53].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[53].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[54].pattern, Equals, "aaaaa")
+	c.Check(patternToCheckEverything[54].componentType, Equals, datetimePatternComponentUnit)
 	c.Check(patternToCheckEverything[55].pattern, Equals, " ")
 	c.Check(patternToCheckEverything[55].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[56].pattern, Equals, " ")
-	c.Check(patternToCheckEverything[56].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[56].pattern, Equals, "Q")
+	c.Check(patternToCheckEverything[56].componentType, Equals, datetimePatternComponentUnit)
 	c.Check(patternToCheckEverything[57].pattern, Equals, " ")
 	c.Check(patternToCheckEverything[57].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[58].pattern, Equals, "literal")
-	c.Check(patternToCheckEverything[58].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[59].pattern, Equals, ":")
+	c.Check(patternToCheckEverything[58].pattern, Equals, "z")
+	c.Check(patternToCheckEverything[58].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[59].pattern, Equals, " ")
 	c.Check(patternToCheckEverything[59].componentType, Equals, datetimePatternComponentLiteral)
-	c.Check(patternToCheckEverything[60].pattern, Equals, "literal")
-	c.Check(patternToCheckEverything[60].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[60].pattern, Equals, "v")
+	c.Check(patternToCheckEverything[60].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(patternToCheckEverything[61].pattern, Equals, " ")
+	c.Check(patternToCheckEverything[61].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[62].pattern, Equals, "literal")
+	c.Check(patternToCheckEverything[62].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[63].pattern, Equals, ":")
+	c.Check(patternToCheckEverything[63].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(patternToCheckEverything[64].pattern, Equals, "literal")
+	c.Check(patternToCheckEverything[64].componentType, Equals, datetimePatternComponentLiteral)
 
 	// check bad-quote errors
 	_, err = tEn.parseDateTimeFormat("'a")
@@ -634,6 +647,74 @@ func (s *MySuite) TestParseDateTimeFormat(c *C) {
 
 	_, err = tEn.parseDateTimeFormat("a'a'a'a")
 	c.Check(err, NotNil)
+
+	_, err = tEn.parseDateTimeFormat("h 'o''clock")
+	c.Check(err, NotNil)
+
+	// pairs of single quotes are single quote literals
+	pattern, err := tEn.parseDateTimeFormat("h''mm 'o''clock' ''''")
+	c.Check(err, IsNil)
+	c.Assert(pattern, HasLen, 8)
+	c.Check(pattern[0].pattern, Equals, "h")
+	c.Check(pattern[0].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(pattern[1].pattern, Equals, "'")
+	c.Check(pattern[1].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(pattern[2].pattern, Equals, "mm")
+	c.Check(pattern[2].componentType, Equals, datetimePatternComponentUnit)
+	c.Check(pattern[3].pattern, Equals, " ")
+	c.Check(pattern[3].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(pattern[4].pattern, Equals, "o'clock")
+	c.Check(pattern[4].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(pattern[5].pattern, Equals, " ")
+	c.Check(pattern[5].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(pattern[6].pattern, Equals, "'")
+	c.Check(pattern[6].componentType, Equals, datetimePatternComponentLiteral)
+	c.Check(pattern[7].pattern, Equals, "'")
+	c.Check(pattern[7].componentType, Equals, datetimePatternComponentLiteral)
+}
+
+func (s *MySuite) TestFormatDateTimePattern(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	tEn, _ := f.GetTranslator("en")
+	tFr, _ := f.GetTranslator("fr")
+
+	c.Assert(tEn, NotNil)
+	c.Assert(tFr, NotNil)
+
+	datetime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	str, err := tEn.FormatDateTimePattern("EEE, d MMM", datetime)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "Mon, 2 Jan")
+
+	str, err = tFr.FormatDateTimePattern("EEEE d MMMM y", datetime)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "lundi 2 janvier 2006")
+
+	str, err = tEn.FormatDateTimePattern("h 'o''clock' a", datetime)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "3 o'clock PM")
+
+	str, err = tEn.FormatDateTimePattern("''yy", datetime)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "'06")
+
+	str, err = tEn.FormatDateTimePattern("HH:mm", datetime)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "15:04")
+
+	_, err = tEn.FormatDateTimePattern("h 'o''clock", datetime)
+	c.Check(err, NotNil)
+
+	_, err = tEn.FormatDateTimePattern("dddd", datetime)
+	c.Check(err, NotNil)
 }
 
 func (s *MySuite) TestGetDateTimePattern(c *C) {
@@ -658,3 +739,206 @@ func (s *MySuite) TestLastSequenceIndex(c *C) {
 	str = "aab"
 	c.Check(lastSequenceIndex(str), Equals, 1)
 }
+
+func (s *MySuite) TestFormatDateTimeEraQuarter(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	tEn, _ := f.GetTranslator("en")
+	tFr, _ := f.GetTranslator("fr")
+
+	c.Assert(tEn, NotNil)
+	c.Assert(tFr, NotNil)
+
+	ad := time.Date(2006, time.August, 2, 15, 4, 5, 0, time.UTC)
+	bc := time.Date(-43, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t        *Translator
+		datetime time.Time
+		pattern  string
+		expected string
+	}{
+		{tEn, ad, "G", "AD"},
+		{tEn, ad, "GGG", "AD"},
+		{tEn, ad, "GGGG", "Anno Domini"},
+		{tEn, ad, "GGGGG", "A"},
+		{tEn, bc, "G", "BC"},
+		{tEn, bc, "GGGG", "Before Christ"},
+		{tEn, bc, "GGGGG", "B"},
+		{tEn, bc, "y", "44"},
+		{tFr, bc, "GGGG", "avant Jésus-Christ"},
+		{tEn, ad, "Q", "3"},
+		{tEn, ad, "QQ", "03"},
+		{tEn, ad, "QQQ", "Q3"},
+		{tEn, ad, "QQQQ", "3rd quarter"},
+		{tEn, ad, "QQQQQ", "3"},
+		{tEn, bc, "QQQQ", "1st quarter"},
+		{tEn, ad, "q", "3"},
+		{tEn, ad, "qqqq", "3rd quarter"},
+		{tFr, ad, "QQQ", "T3"},
+		{tFr, ad, "QQQQ", "3e trimestre"},
+	}
+
+	for _, test := range tests {
+		str, err := test.t.formatDateTimeComponent(test.datetime, test.pattern)
+		c.Check(err, IsNil, Commentf(test.pattern))
+		c.Check(str, Equals, test.expected, Commentf(test.pattern))
+	}
+
+	pattern, err := tEn.parseDateTimeFormat("QQQQ y G")
+	c.Assert(err, IsNil)
+	str, err := tEn.formatDateTime(bc, pattern)
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "1st quarter 44 BC")
+
+	// stand-alone quarters use their own names when the locale has them
+	rules := new(TranslatorRules)
+	rules.merge(tEn.rules)
+	rules.DateTime.StandAloneNames.Quarters.Wide.Quarter3 = "third quarter"
+	tStandAlone := &Translator{rules: rules}
+
+	str, _ = tStandAlone.formatDateTimeComponent(ad, "qqqq")
+	c.Check(str, Equals, "third quarter")
+	str, _ = tStandAlone.formatDateTimeComponent(ad, "QQQQ")
+	c.Check(str, Equals, "3rd quarter")
+
+	for _, pattern := range []string{"GGGGGG", "QQQQQQ", "qqqqqq"} {
+		_, err := tEn.formatDateTimeComponent(ad, pattern)
+		c.Check(err, NotNil, Commentf(pattern))
+	}
+}
+
+func (s *MySuite) TestFormatDateTimeSymbols(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	translators := map[string]*Translator{}
+	for _, locale := range []string{"en", "en-GB", "de", "fr", "ru"} {
+		translators[locale], _ = f.GetTranslator(locale)
+		c.Assert(translators[locale], NotNil)
+	}
+
+	at := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	datetime := time.Date(2006, time.January, 2, 15, 4, 5, 123456789, time.UTC)
+	july := at(2006, time.July, 15, 9, 0)
+
+	tests := []struct {
+		locale   string
+		datetime time.Time
+		pattern  string
+		expected string
+	}{
+		// years
+		{"en", datetime, "yyy", "2006"},
+		{"en", at(17, time.May, 1, 0, 0), "yyy", "017"},
+		{"en", datetime, "yyyyy", "02006"},
+		{"en", datetime, "u", "2006"},
+		{"en", at(-43, time.March, 15, 0, 0), "u", "-43"},
+		{"en", datetime, "uuuuu", "02006"},
+		{"en", datetime, "U", "2006"},
+		{"en", datetime, "r", "2006"},
+
+		// week years and weeks, with the locale's week rules
+		{"en", at(2006, time.January, 1, 0, 0), "Y", "2006"},
+		{"en", at(2006, time.January, 1, 0, 0), "w", "1"},
+		{"en-GB", at(2006, time.January, 1, 0, 0), "Y", "2005"},
+		{"en-GB", at(2006, time.January, 1, 0, 0), "w", "52"},
+		{"en-GB", datetime, "ww", "01"},
+		{"en", at(2008, time.December, 29, 0, 0), "YYYY w", "2009 1"},
+		{"en-GB", at(2008, time.December, 29, 0, 0), "YY", "09"},
+		{"en", july, "w", "28"},
+		{"en-GB", july, "w", "28"},
+		{"en", july, "W", "3"},
+		{"en-GB", july, "W", "2"},
+		{"en-GB", at(2006, time.July, 1, 0, 0), "W", "0"},
+
+		// days
+		{"en", datetime, "D", "2"},
+		{"en", datetime, "DDD", "002"},
+		{"en", july, "D", "196"},
+		{"en", july, "F", "3"},
+		{"en", datetime, "g", "53737"},
+		{"en", datetime, "e", "2"},
+		{"en", datetime, "ee", "02"},
+		{"en-GB", datetime, "e", "1"},
+		{"en", datetime, "eee", "Mon"},
+		{"en", datetime, "eeee", "Monday"},
+		{"en", datetime, "eeeee", "M"},
+		{"en", datetime, "eeeeee", "Mo"},
+		{"en", datetime, "c", "2"},
+		{"en", datetime, "cc", "2"},
+		{"en", datetime, "ccc", "Mon"},
+		{"en", datetime, "cccc", "Monday"},
+
+		// stand-alone months
+		{"en", datetime, "L", "1"},
+		{"en", datetime, "LL", "01"},
+		{"en", datetime, "LLL", "Jan"},
+		{"en", datetime, "LLLL", "January"},
+		{"en", datetime, "LLLLL", "J"},
+		{"ru", datetime, "MMMM", "января"},
+		{"ru", datetime, "LLLL", "январь"},
+		{"ru", datetime, "LLLLL", "Я"},
+		{"de", at(2006, time.March, 1, 0, 0), "MMM", "Mär."},
+		{"de", at(2006, time.March, 1, 0, 0), "LLL", "Mär"},
+
+		// hours, seconds and fractions of seconds
+		{"en", at(2006, time.January, 2, 0, 30), "h", "12"},
+		{"en", at(2006, time.January, 2, 0, 30), "k", "24"},
+		{"en", datetime, "kk", "15"},
+		{"en", datetime, "K", "3"},
+		{"en", at(2006, time.January, 2, 12, 0), "KK", "00"},
+		{"en", datetime, "S", "1"},
+		{"en", datetime, "SSS", "123"},
+		{"en", datetime, "SSSSSSSSSSSS", "123456789000"},
+		{"en", datetime, "A", "54245123"},
+
+		// day periods
+		{"en", datetime, "b", "PM"},
+		{"en", at(2006, time.January, 2, 12, 0), "b", "noon"},
+		{"en", at(2006, time.January, 2, 12, 0), "bbbbb", "n"},
+		{"en", at(2006, time.January, 2, 0, 0), "bbbb", "midnight"},
+		{"en", at(2006, time.January, 2, 0, 1), "b", "AM"},
+		{"de", at(2006, time.January, 2, 12, 0), "b", "nachm."},
+		{"de", at(2006, time.January, 2, 0, 0), "b", "Mitternacht"},
+		{"en", datetime, "B", "in the afternoon"},
+		{"en", at(2006, time.January, 2, 7, 0), "B", "in the morning"},
+		{"en", at(2006, time.January, 2, 19, 0), "BBBB", "in the evening"},
+		{"en", at(2006, time.January, 2, 22, 0), "B", "at night"},
+		{"en", at(2006, time.January, 2, 3, 0), "B", "at night"},
+		{"en", at(2006, time.January, 2, 12, 0), "B", "noon"},
+		{"de", at(2006, time.January, 2, 10, 30), "B", "vorm."},
+		{"de", at(2006, time.January, 2, 10, 30), "BBBB", "vormittags"},
+		{"de", at(2006, time.January, 2, 12, 30), "B", "mittags"},
+		{"de", at(2006, time.January, 2, 20, 0), "B", "abends"},
+		{"fr", datetime, "B", "PM"},
+	}
+
+	for _, test := range tests {
+		comment := Commentf("%s %s %s", test.locale, test.datetime, test.pattern)
+		pattern, err := translators[test.locale].parseDateTimeFormat(test.pattern)
+		c.Assert(err, IsNil, comment)
+		str, err := translators[test.locale].formatDateTime(test.datetime, pattern)
+		c.Check(err, IsNil, comment)
+		c.Check(str, Equals, test.expected, comment)
+	}
+
+	for _, pattern := range []string{"LLLLLL", "www", "WW", "DDDD", "FF", "cccccccc", "kkk", "KKK", "bbbbbb", "BBBBBB"} {
+		_, err := translators["en"].formatDateTimeComponent(datetime, pattern)
+		c.Check(err, NotNil, Commentf(pattern))
+	}
+}
@@ -8,6 +8,7 @@ Package i18n offers the following basic internationalization functionality:
 	- message translation
 		- with placeholder support
 		- with plural support
+		- with ICU MessageFormat plural, selectordinal and select support
 	- number formatting
 		- with currency support
 		- with percentage support
@@ -69,6 +70,91 @@ For a basic example of getting a TranslatorFactory instance:
 		_ = tEn
 	}
 
+To ship your rules and messages inside your binary, use NewTranslatorFactoryFS
+with file systems instead of paths, like an embed.FS. File systems are layered
+the same way as paths.
+
+	//go:embed rules messages
+	var data embed.FS
+
+	func main() {
+
+		rules, _ := fs.Sub(data, "rules")
+		messages, _ := fs.Sub(data, "messages")
+
+		f, _ := i18n.NewTranslatorFactoryFS(
+			[]fs.FS{rules},
+			[]fs.FS{messages},
+			"en",
+		)
+
+		tEn, _ := f.GetTranslator("en")
+
+		_ = tEn
+	}
+
+Rules and messages can also come from somewhere other than files, like a
+database or a translation service. Implement the RulesSource or MessageSource
+interface for your backend, and pass your sources to
+NewTranslatorFactoryFromSources. FSSource loads files from a file system, and
+MemorySource keeps rules and messages in memory, which is handy for tests.
+
+	m := i18n.NewMemorySource()
+	m.AddMessages("en", map[string]interface{}{"WELCOME": "Welcome!"})
+
+	f, _ := i18n.NewTranslatorFactoryFromSources(
+		[]i18n.RulesSource{i18n.NewDirSource(rulesPath)},
+		[]i18n.MessageSource{i18n.NewDirSource(messagesPath), m},
+		"en",
+	)
+
+HTTPSource fetches messages bundles from a translation server, keeping them for
+as long as the server's Cache-Control header allows (but at least for its
+MinFreshness, a minute by default) and revalidating them with their ETags.
+With a cache directory, it saves the last good bundle for each locale, so
+translations keep working while the server is down.
+
+	remote := i18n.NewHTTPSource("https://translations.example.com/bundles/{locale}.json", "/var/cache/myapp/messages")
+
+A factory caches the Translators it creates. To pick up changes to rules and
+messages without restarting, call Reload, which rebuilds the Translators and
+swaps them in at once. A locale that can't be rebuilt without errors keeps its
+last good Translator. Watch reloads the factory whenever its files change, and
+OnReload registers a function to call after each reload.
+
+	f.OnReload(func(locales []string, errors []error) {
+		for _, err := range errors {
+			log.Println("reloading messages:", err)
+		}
+	})
+	stop := f.Watch(5 * time.Second)
+	defer stop()
+
+To pick a Translator for an HTTP request, pass its Accept-Language header to
+Negotiate. It returns the best Translator, the locale it's for, and how
+confident the match is - exact, a less specific locale ("fr" for "fr-CH"), or
+the factory's fallback locale.
+
+	t, locale, confidence, _ := f.Negotiate(r.Header.Get("Accept-Language"))
+
+Middleware does this for every request. It resolves the locale from a query
+parameter, a cookie and the Accept-Language header - or from the URL path, for
+the locales passed to LocaleFromPath - stores the Translator in the request's
+context, and sets the Content-Language and Vary headers. Handlers get the
+Translator with FromContext.
+
+	http.ListenAndServe(":8080", f.Middleware()(mux))
+
+Locale codes are canonicalized as BCP 47 language tags before their files are
+looked up, so "en_gb", "EN-GB" and "en-GB" all get the same Translator, files
+named "en_GB.po" or "en-gb.yaml" are found for it, deprecated codes like "iw"
+are replaced ("he"), and scripts implied by a region are added - "zh-TW"
+becomes "zh-Hant-TW", which falls back to "zh-Hant" and then "zh". Languages
+written in more than one script fall back through their likely script too, so
+"zh-CN" falls back to "zh-Hans" and then "zh", and extensions and variants are
+left out of fallbacks, so "en-US-POSIX" falls back to "en-US". Use
+CanonicalLocale to get the code a Translator will have.
+
 Simple Message Translation
 
 For simple message translation, use the Translate function, and send an empty
@@ -93,6 +179,16 @@ map as the second argument (we'll explain that argument in the next section).
 		_ = translation
 	}
 
+Messages files can also group messages in nested maps, as deeply as you like.
+Nested messages are translated using their dotted key:
+
+	checkout:
+	  title: "Checkout"
+	  payment:
+	    button: "Pay now"
+
+	translation, _ := tEn.Translate("checkout.payment.button", map[string]string{})
+
 Message Translation with Placeholders
 
 You can also pass placeholder values to the translate function.  That's what the
@@ -124,6 +220,44 @@ translation.
 	}
 
 
+ICU MessageFormat Messages
+
+Messages passed through the Translate function are ICU MessageFormat strings.
+Along with simple placeholders, they can contain plural, selectordinal and
+select arguments, which can be nested inside one another. Inside plural sub
+messages, a # is replaced with the number. Plural categories are chosen using
+the locale's plural rules.
+
+	func main() {
+
+		rulesPath := "/usr/local/lib/i18n/locales/rules"
+		messagesPath := "/usr/local/lib/i18n/locales/messages"
+
+		f, _ := i18n.NewTranslatorFactory(
+			[]string{rulesPath},
+			[]string{messagesPath},
+			"en",
+		)
+
+		tEn, _ := i18n.GetTranslator("en")
+
+		// CART_ITEMS => "{count, plural, =0 {Your cart is empty} one {# item} other {# items}}"
+		translation1, _ := tEn.Translate("CART_ITEMS", map[string]string{"count": "3"})
+
+		// PHOTO_LIKED => "{gender, select, female {She} male {He} other {They}} liked your photo"
+		translation2, _ := tEn.Translate("PHOTO_LIKED", map[string]string{"gender": "female"})
+
+		// results in "3 items" and "She liked your photo"
+
+		_ = translation1
+		_ = translation2
+	}
+
+Use a doubled apostrophe for a literal apostrophe next to a brace, and an
+apostrophe before a brace or # to quote it - "'{'literal'}'". Apostrophes
+elsewhere, like in "l'heure", don't need any escaping.
+
+
 Plural Message Translation
 
 You can also translate strings with plurals. However, any one message can
@@ -137,6 +271,11 @@ Why two arguments for the number instead of one? This allows you ultimate
 flexibility in number formatting to use in the translation while eliminating the
 need for string number parsing.
 
+The string representation still matters when choosing the plural form, though.
+When it's the same number formatted for the locale, its visible fraction digits
+are used the way CLDR describes - in English "1 day" is singular but "1.0 days"
+is not.
+
 	func main() {
 
 		rulesPath := "/usr/local/lib/i18n/locales/rules"
@@ -160,6 +299,123 @@ need for string number parsing.
 		_ = translation2
 	}
 
+Rather than relying on the order of "|" separated variants, plural messages can
+also name their variants after the CLDR plural categories - zero, one, two,
+few, many and other. Each locale uses a different set of these categories, and
+Pluralize returns an error when a message is missing one that the locale needs.
+
+	DAYS_AGO:
+	  one   : "{n} day ago"
+	  other : "{n} days ago"
+
+For ordinal numbers - "1st", "2nd", "3rd" - use the PluralizeOrdinal method. It
+takes the same arguments as Pluralize, but picks the variant using the locale's
+ordinal rules instead of its plural rules.
+
+	PLACE:
+	  one   : "{n}st place"
+	  two   : "{n}nd place"
+	  few   : "{n}rd place"
+	  other : "{n}th place"
+
+For ranges of numbers - "1-3 days" - use the PluralizeRange method. It takes
+the start and end of the range, picks the variant using the locale's plural
+ranges and replaces the {start} and {end} placeholders with the formatted
+numbers.
+
+	DAY_RANGE:
+	  one   : "{start}-{end} day"
+	  other : "{start}-{end} days"
+
+
+Select Message Translation
+
+Messages can also have variants named after anything else, like grammatical
+gender. The Select method takes the message key, the name of the variant to
+use, and substitutions just like the Translate method. The "other" variant is
+used when there's no variant with the requested name.
+
+	FRIEND_REQUEST:
+	  female : "{name} wants to add you to her friends"
+	  male   : "{name} wants to add you to his friends"
+	  other  : "{name} wants to add you to their friends"
+
+	translation, _ := tEn.Select("FRIEND_REQUEST", "female", map[string]string{"name": "Ann"})
+
+
+Message Context
+
+Sometimes the same text needs different translations depending on where it's
+used - "Open" on a button is a verb, but "Open" on a store's hours is an
+adjective. Rather than inventing keys for each, group the messages by context
+under the "_context" key of your messages file, and use the TranslateContext
+method. When a translator has no message for the key in the requested context,
+its message without a context is used.
+
+	OPEN: "Open"
+
+	_context:
+	  store:
+	    OPEN: "Open now"
+
+	translation, _ := tEn.TranslateContext("store", "OPEN", map[string]string{})
+
+Plural messages can have contexts too. Use the PluralizeContext,
+PluralizeOrdinalContext and PluralizeRangeContext methods, which take the
+context before the same arguments as Pluralize, PluralizeOrdinal and
+PluralizeRange.
+
+	_context:
+	  cart:
+	    ITEM_COUNT:
+	      one   : "{n} item in your cart"
+	      other : "{n} items in your cart"
+
+	translation, _ := tEn.PluralizeContext("cart", "ITEM_COUNT", 2, "2")
+
+
+Gettext Catalogs
+
+Messages can also be loaded from gettext PO and compiled MO files, named like
+your YAML messages files (fr.po, or fr/front-end.po). A msgctxt is used as the
+message's context, and plural messages use the msgid as their key. The
+catalog's Plural-Forms header decides which msgstr[n] form is used for each of
+the locale's plural categories - Latvian's msgstr[2] is its "zero" form - and
+catalogs without one use the forms for the categories in order. Fuzzy,
+obsolete and untranslated entries are skipped.
+
+	msgctxt "store"
+	msgid "OPEN"
+	msgstr "Ouvert"
+
+	msgid "{n} file"
+	msgid_plural "{n} files"
+	msgstr[0] "{n} fichier"
+	msgstr[1] "{n} fichiers"
+
+	translation, _ := tFr.Pluralize("{n} file", 2, "2")
+
+
+XLIFF
+
+To send messages to translators using CAT tools, export them as an XLIFF 1.2
+or 2.0 file with the ExportXLIFF function. It includes a unit for each of the
+source translator's messages, with the target translator's translation if it
+has one. Plural messages have a unit for each of the target locale's plural
+categories.
+
+	err := i18n.ExportXLIFF(w, tEn, tFr, i18n.XLIFF20)
+
+Translated XLIFF files can be read with ReadXLIFF, and written as a YAML
+messages file with the document's WriteMessages method. Notes and translation
+states are kept as comments in the messages file, and targets whose state says
+they still need translating, like "new" or "initial", are left out.
+
+	d, err := i18n.ReadXLIFF(r)
+	err = d.WriteMessages(w)
+
+The i18n-xliff command in cmd/i18n-xliff does both from the command line.
+
 
 Number Formatting
 
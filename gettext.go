@@ -0,0 +1,309 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+// moMagic is the magic number at the start of a gettext MO file
+const moMagic = 0x950412de
+
+// poEntry is a single translation entry in a gettext PO file
+type poEntry struct {
+	context     string
+	id          string
+	idPlural    string
+	translation string
+	plurals     map[int]string
+	fuzzy       bool
+	hasContext  bool
+	hasPlural   bool
+	field       string
+	pluralIndex int
+}
+
+// parseMessagesPO parses the contents of a gettext PO file into the messages
+// and variants maps. Messages with a msgctxt are stored with their context,
+// and the msgstr[n] forms of plural messages are stored as variants for the
+// locale's plural categories, using the header's Plural-Forms to tell which
+// form is for which category. Fuzzy and obsolete entries, untranslated
+// entries and the header entry are skipped. The locale's rules may be nil.
+func parseMessagesPO(contents []byte, rules *TranslatorRules, messages map[string]string, variants map[string]map[string]string) error {
+	plurals := newGettextPlurals(rules)
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(make([]byte, 64*1024), len(contents)+1)
+
+	entry := &poEntry{}
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		// flags come before the entry they belong to. Obsolete entries are
+		// commented out with "#~", so they're skipped along with comments
+		if strings.HasPrefix(line, "#") {
+			if entry.field != "" {
+				err := addPOEntry(entry, plurals, messages, variants)
+				if err != nil {
+					return err
+				}
+				entry = &poEntry{}
+			}
+
+			if strings.HasPrefix(line, "#,") {
+				for _, flag := range strings.Split(line[2:], ",") {
+					if strings.TrimSpace(flag) == "fuzzy" {
+						entry.fuzzy = true
+					}
+				}
+			}
+			continue
+		}
+
+		// continuation of the previous keyword's string
+		if strings.HasPrefix(line, "\"") {
+			str, err := unquotePO(line, lineNumber)
+			if err != nil {
+				return err
+			}
+			if !entry.appendString(str) {
+				return poError(lineNumber, "string without a keyword")
+			}
+			continue
+		}
+
+		keyword := line
+		value := ""
+		if pos := strings.IndexAny(line, " \t"); pos != -1 {
+			keyword = line[:pos]
+			value = strings.TrimSpace(line[pos:])
+		}
+
+		str, err := unquotePO(value, lineNumber)
+		if err != nil {
+			return err
+		}
+
+		// a new msgctxt or msgid starts a new entry, unless it's part of an
+		// entry that doesn't have its msgid yet
+		if (keyword == "msgctxt" || keyword == "msgid") && entry.field != "" && entry.field != "msgctxt" {
+			err = addPOEntry(entry, plurals, messages, variants)
+			if err != nil {
+				return err
+			}
+			entry = &poEntry{}
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			entry.hasContext = true
+			entry.context = str
+		case keyword == "msgid":
+			entry.id = str
+		case keyword == "msgid_plural":
+			entry.hasPlural = true
+			entry.idPlural = str
+		case keyword == "msgstr":
+			entry.translation = str
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			index, convErr := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if convErr != nil || index < 0 {
+				return poError(lineNumber, "invalid plural form "+keyword)
+			}
+			if entry.plurals == nil {
+				entry.plurals = map[int]string{}
+			}
+			entry.plurals[index] = str
+			entry.pluralIndex = index
+		default:
+			return poError(lineNumber, "unknown keyword "+keyword)
+		}
+
+		entry.field = keyword
+		if strings.HasPrefix(keyword, "msgstr[") {
+			entry.field = "msgstr[]"
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return translatorError{message: "can't load messages PO: " + err.Error()}
+	}
+
+	if entry.field != "" {
+		return addPOEntry(entry, plurals, messages, variants)
+	}
+
+	return nil
+}
+
+// appendString adds a continuation string to the field that was last set in
+// the entry. It returns false if no field has been set yet.
+func (e *poEntry) appendString(str string) bool {
+	switch e.field {
+	case "msgctxt":
+		e.context += str
+	case "msgid":
+		e.id += str
+	case "msgid_plural":
+		e.idPlural += str
+	case "msgstr":
+		e.translation += str
+	case "msgstr[]":
+		e.plurals[e.pluralIndex] += str
+	default:
+		return false
+	}
+	return true
+}
+
+// addPOEntry adds a parsed PO entry to the messages and variants maps, unless
+// it should be skipped. The header entry's Plural-Forms are read into the
+// plural forms mapping.
+func addPOEntry(entry *poEntry, plurals *gettextPlurals, messages map[string]string, variants map[string]map[string]string) error {
+	if entry.id == "" && !entry.hasContext && !entry.hasPlural {
+		return plurals.readHeader(entry.translation)
+	}
+
+	if entry.fuzzy || entry.id == "" {
+		return nil
+	}
+
+	key := entry.id
+	if entry.hasContext {
+		key = contextKey(entry.context, entry.id)
+	}
+
+	if !entry.hasPlural {
+		if entry.translation != "" {
+			messages[key] = entry.translation
+		}
+		return nil
+	}
+
+	forms := make([]string, len(entry.plurals))
+	for index, str := range entry.plurals {
+		if index >= len(forms) {
+			return translatorError{message: "can't load messages PO: missing plural forms for " + entry.id}
+		}
+		forms[index] = str
+	}
+
+	return plurals.add(key, forms, variants)
+}
+
+// unquotePO returns the contents of a quoted PO string
+func unquotePO(str string, lineNumber int) (unquoted string, err error) {
+	if len(str) < 2 || str[0] != '"' || str[len(str)-1] != '"' {
+		err = poError(lineNumber, "expected a quoted string, found "+str)
+		return
+	}
+
+	unquoted, unquoteErr := strconv.Unquote(str)
+	if unquoteErr != nil {
+		err = poError(lineNumber, "invalid string "+str)
+	}
+	return
+}
+
+// poError returns a translatorError for a malformed PO file
+func poError(lineNumber int, message string) error {
+	return translatorError{message: "can't load messages PO: line " + strconv.Itoa(lineNumber) + ": " + message}
+}
+
+// parseMessagesMO parses the contents of a compiled gettext MO file into the
+// messages and variants maps, in the same way as parseMessagesPO. Both little
+// and big endian files are supported.
+func parseMessagesMO(contents []byte, rules *TranslatorRules, messages map[string]string, variants map[string]map[string]string) error {
+	if len(contents) < 20 {
+		return translatorError{message: "can't load messages MO: file is too short"}
+	}
+
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(contents) == moMagic:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(contents) == moMagic:
+		order = binary.BigEndian
+	default:
+		return translatorError{message: "can't load messages MO: invalid magic number"}
+	}
+
+	if revision := order.Uint32(contents[4:]) >> 16; revision > 1 {
+		return translatorError{message: "can't load messages MO: unsupported revision " + strconv.Itoa(int(revision))}
+	}
+
+	plurals := newGettextPlurals(rules)
+
+	count := order.Uint32(contents[8:])
+	originals := order.Uint32(contents[12:])
+	translations := order.Uint32(contents[16:])
+
+	// str returns the string described by the nth entry of a length and offset
+	// table
+	str := func(table uint32, n uint32) (s string, ok bool) {
+		pos := uint64(table) + uint64(n)*8
+		if pos+8 > uint64(len(contents)) {
+			return
+		}
+
+		length := uint64(order.Uint32(contents[pos:]))
+		offset := uint64(order.Uint32(contents[pos+4:]))
+		if offset+length > uint64(len(contents)) {
+			return
+		}
+
+		return string(contents[offset : offset+length]), true
+	}
+
+	for n := uint32(0); n < count; n++ {
+		original, ok := str(originals, n)
+		if !ok {
+			return translatorError{message: "can't load messages MO: invalid original string table"}
+		}
+
+		translation, ok := str(translations, n)
+		if !ok {
+			return translatorError{message: "can't load messages MO: invalid translation string table"}
+		}
+
+		// the header is stored with an empty msgid, and comes first, since
+		// the strings are sorted
+		if original == "" {
+			if err := plurals.readHeader(translation); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the msgid_plural follows the msgid, and the forms of a plural
+		// translation are separated the same way
+		id := original
+		plural := false
+		if pos := strings.Index(original, "\x00"); pos != -1 {
+			id = original[:pos]
+			plural = true
+		}
+
+		// msgctxt is stored before the msgid with the same separator used
+		// for contexts in the messages map
+		if plural {
+			err := plurals.add(id, strings.Split(translation, "\x00"), variants)
+			if err != nil {
+				return err
+			}
+		} else if translation != "" {
+			messages[id] = translation
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+var ruPO = `# Russian translations
+msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+#: app.go:12
+msgid "WELCOME"
+msgstr "Добро пожаловать!"
+
+msgid "LONG"
+msgstr ""
+"Первая строка, "
+"вторая строка\n"
+
+msgctxt "store"
+msgid "OPEN"
+msgstr "Открыто"
+
+msgid "OPEN"
+msgstr "Открыть"
+
+msgid "{n} file"
+msgid_plural "{n} files"
+msgstr[0] "{n} файл"
+msgstr[1] "{n} файла"
+msgstr[2] "{n} файлов"
+
+#, fuzzy, go-format
+msgid "FUZZY"
+msgstr "Нечётко"
+
+msgid "UNTRANSLATED"
+msgstr ""
+
+#~ msgid "OBSOLETE"
+#~ msgstr "Устарело"
+`
+
+var lvPO = `msgid ""
+msgstr ""
+"Language: lv\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n != 0 ? 1 : 2);\n"
+
+msgid "{n} file"
+msgid_plural "{n} files"
+msgstr[0] "{n} fails"
+msgstr[1] "{n} faili"
+msgstr[2] "{n} failu"
+`
+
+// namedPluralRules returns compiled rules with one of the named plural rules
+func namedPluralRules(plural string) *TranslatorRules {
+	rules := &TranslatorRules{Plural: plural}
+	rules.compile()
+	return rules
+}
+
+// buildMO returns a little endian MO file containing the original strings
+// and translations
+func buildMO(originals []string, translations []string) []byte {
+	count := len(originals)
+	headerSize := 28
+	tableSize := count * 8
+
+	var strs bytes.Buffer
+	offset := headerSize + tableSize*2
+
+	var originalTable, translationTable bytes.Buffer
+	for i := 0; i < count; i++ {
+		binary.Write(&originalTable, binary.LittleEndian, []uint32{uint32(len(originals[i])), uint32(offset + strs.Len())})
+		strs.WriteString(originals[i] + "\x00")
+	}
+	for i := 0; i < count; i++ {
+		binary.Write(&translationTable, binary.LittleEndian, []uint32{uint32(len(translations[i])), uint32(offset + strs.Len())})
+		strs.WriteString(translations[i] + "\x00")
+	}
+
+	var mo bytes.Buffer
+	binary.Write(&mo, binary.LittleEndian, []uint32{
+		moMagic, 0, uint32(count), uint32(headerSize), uint32(headerSize + tableSize), 0, 0,
+	})
+	mo.Write(originalTable.Bytes())
+	mo.Write(translationTable.Bytes())
+	mo.Write(strs.Bytes())
+
+	return mo.Bytes()
+}
+
+func (s *MySuite) TestParseMessagesPO(c *C) {
+	ru := namedPluralRules("4B")
+
+	messages := map[string]string{}
+	variants := map[string]map[string]string{}
+	err := parseMessagesPO([]byte(ruPO), ru, messages, variants)
+	c.Assert(err, IsNil)
+
+	c.Check(messages, DeepEquals, map[string]string{
+		"WELCOME":       "Добро пожаловать!",
+		"LONG":          "Первая строка, вторая строка\n",
+		"store\x04OPEN": "Открыто",
+		"OPEN":          "Открыть",
+	})
+	c.Check(variants, DeepEquals, map[string]map[string]string{
+		"{n} file": {"one": "{n} файл", "few": "{n} файла", "many": "{n} файлов", "other": "{n} файлов"},
+	})
+
+	// entries after an obsolete entry aren't skipped
+	messages = map[string]string{}
+	err = parseMessagesPO([]byte(`msgid "a"
+msgstr "A"
+
+#~ msgid "old"
+#~ msgstr "Old"
+
+msgid "new"
+msgstr "New"
+`), ru, messages, variants)
+	c.Assert(err, IsNil)
+	c.Check(messages, DeepEquals, map[string]string{"a": "A", "new": "New"})
+
+	// gettext's Latvian forms are one, other and zero, in that order
+	variants = map[string]map[string]string{}
+	err = parseMessagesPO([]byte(lvPO), namedPluralRules("3A"), messages, variants)
+	c.Assert(err, IsNil)
+	c.Check(variants, DeepEquals, map[string]map[string]string{
+		"{n} file": {"zero": "{n} failu", "one": "{n} fails", "other": "{n} faili"},
+	})
+
+	// without Plural-Forms, forms are in the order of the categories, so
+	// there can't be more forms than categories
+	withoutHeader := ruPO[strings.Index(ruPO, "#: app.go"):]
+	err = parseMessagesPO([]byte(withoutHeader), namedPluralRules("2A"), messages, variants)
+	c.Check(err, NotNil)
+
+	err = parseMessagesPO([]byte(withoutHeader), ru, messages, variants)
+	c.Check(err, IsNil)
+
+	// invalid Plural-Forms
+	err = parseMessagesPO([]byte(strings.Replace(lvPO, "n != 0 ? 1 : 2", "n != ? 1 : 2", 1)), namedPluralRules("3A"), messages, variants)
+	c.Check(err, NotNil)
+
+	// malformed files
+	for _, po := range []string{
+		`msgid "UNTERMINATED`,
+		`msgid "A"` + "\n" + `msgtxt "B"`,
+		`"no keyword"`,
+		`msgid "A"` + "\n" + `msgstr[x] "B"`,
+	} {
+		err = parseMessagesPO([]byte(po), ru, messages, variants)
+		c.Check(err, NotNil, Commentf(po))
+	}
+}
+
+func (s *MySuite) TestParseMessagesMO(c *C) {
+	fr := namedPluralRules("2C")
+
+	mo := buildMO(
+		[]string{"", "WELCOME", "store\x04OPEN", "{n} file\x00{n} files", "UNTRANSLATED"},
+		[]string{"Language: fr\n", "Bienvenue !", "Ouvert", "{n} fichier\x00{n} fichiers", ""},
+	)
+
+	messages := map[string]string{}
+	variants := map[string]map[string]string{}
+	err := parseMessagesMO(mo, fr, messages, variants)
+	c.Assert(err, IsNil)
+
+	c.Check(messages, DeepEquals, map[string]string{
+		"WELCOME":       "Bienvenue !",
+		"store\x04OPEN": "Ouvert",
+	})
+	c.Check(variants, DeepEquals, map[string]map[string]string{
+		"{n} file": {"one": "{n} fichier", "other": "{n} fichiers"},
+	})
+
+	// Latvian forms are mapped with the header's Plural-Forms
+	lvMO := buildMO(
+		[]string{"", "{n} file\x00{n} files"},
+		[]string{"Language: lv\nPlural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n != 0 ? 1 : 2);\n", "{n} fails\x00{n} faili\x00{n} failu"},
+	)
+	variants = map[string]map[string]string{}
+	err = parseMessagesMO(lvMO, namedPluralRules("3A"), messages, variants)
+	c.Assert(err, IsNil)
+	c.Check(variants, DeepEquals, map[string]map[string]string{
+		"{n} file": {"zero": "{n} failu", "one": "{n} fails", "other": "{n} faili"},
+	})
+
+	// malformed files
+	err = parseMessagesMO([]byte("short"), fr, messages, variants)
+	c.Check(err, NotNil)
+
+	err = parseMessagesMO(append([]byte{0, 0, 0, 0}, mo[4:]...), fr, messages, variants)
+	c.Check(err, NotNil)
+
+	err = parseMessagesMO(mo[:40], fr, messages, variants)
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestLoadMessagesGettext(c *C) {
+	dir := c.MkDir()
+
+	err := ioutil.WriteFile(dir+"/ru.po", []byte(ruPO), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	err = ioutil.WriteFile(dir+"/fr.mo", buildMO(
+		[]string{"WELCOME", "{n} file\x00{n} files"},
+		[]string{"Bienvenue !", "{n} fichier\x00{n} fichiers"},
+	), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	f, errors := NewTranslatorFactory([]string{"data/rules"}, []string{"data/messages", dir}, "en")
+	c.Check(errors, HasLen, 0)
+
+	tRu, errors := f.GetTranslator("ru")
+	c.Assert(tRu, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	m, errors := tRu.Translate("WELCOME", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Добро пожаловать!")
+
+	m, errors = tRu.TranslateContext("store", "OPEN", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Открыто")
+
+	// gettext's three Russian forms are CLDR's one, few and many
+	for n, expected := range map[string]string{"1": "1 файл", "3": "3 файла", "5": "5 файлов", "21": "21 файл"} {
+		number, _ := strconv.ParseFloat(n, 64)
+		m, errors = tRu.Pluralize("{n} file", number, n)
+		c.Check(errors, HasLen, 0)
+		c.Check(m, Equals, expected)
+	}
+
+	tFr, errors := f.GetTranslator("fr")
+	c.Assert(tFr, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	m, errors = tFr.Translate("WELCOME", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Bienvenue !")
+
+	m, errors = tFr.Pluralize("{n} file", 2, "2")
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "2 fichiers")
+}
+
+func (s *MySuite) TestCompileGettextPlural(c *C) {
+	tests := []struct {
+		expression string
+		forms      map[uint64]uint64
+	}{
+		{"n != 1", map[uint64]uint64{0: 1, 1: 0, 2: 1}},
+		{"(n > 1)", map[uint64]uint64{0: 0, 1: 0, 2: 1}},
+		{"0", map[uint64]uint64{0: 0, 5: 0}},
+		{"n%10==1 && n%100!=11 ? 0 : n != 0 ? 1 : 2", map[uint64]uint64{0: 2, 1: 0, 2: 1, 11: 1, 21: 0}},
+		{"n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2", map[uint64]uint64{1: 0, 3: 1, 12: 2, 22: 1, 25: 2}},
+		{"!(n == 1) + n/0 * 2 - n%0", map[uint64]uint64{1: 0, 2: 1}},
+	}
+
+	for _, test := range tests {
+		plural, err := compileGettextPlural(test.expression)
+		c.Assert(err, IsNil, Commentf(test.expression))
+		for n, form := range test.forms {
+			c.Check(plural(n), Equals, form, Commentf("%s %d", test.expression, n))
+		}
+	}
+
+	for _, expression := range []string{"", "n +", "(n", "n ? 1", "n 1", "x"} {
+		_, err := compileGettextPlural(expression)
+		c.Check(err, NotNil, Commentf(expression))
+	}
+}
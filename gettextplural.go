@@ -0,0 +1,372 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// gettextPluralSamples is how many integers, starting from 0, are run through
+// a catalog's Plural-Forms expression and the locale's plural rule to find
+// which form each plural category uses
+const gettextPluralSamples = 1000
+
+// gettextPluralExpression is a compiled gettext Plural-Forms expression, which
+// returns the index of the plural form for a number
+type gettextPluralExpression func(n uint64) uint64
+
+// gettextPlurals maps the numbered plural forms of a gettext catalog to the
+// plural categories of the locale it's loaded for
+type gettextPlurals struct {
+	categories []pluralCategory
+	rule       pluralOperandsRule
+
+	// forms holds the index of the form each category uses, found from the
+	// catalog's Plural-Forms header. It's nil for catalogs without one, whose
+	// forms are used in the order of the locale's categories.
+	forms map[pluralCategory]int
+}
+
+// newGettextPlurals returns the plural forms mapping for a locale's rules,
+// which may be nil
+func newGettextPlurals(rules *TranslatorRules) *gettextPlurals {
+	p := &gettextPlurals{}
+	if rules != nil {
+		p.categories = rules.PluralCategories
+		p.rule = rules.pluralOperandsFunc
+	}
+	return p
+}
+
+// readHeader reads the Plural-Forms of a catalog's header entry, like
+// "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n != 0 ? 1 : 2);", and maps
+// each of the locale's plural categories to the form gettext uses for the
+// smallest integer in the category. Categories without any integers, like
+// Russian's "other", aren't mapped.
+func (p *gettextPlurals) readHeader(header string) error {
+	pluralForms := ""
+	for _, line := range strings.Split(header, "\n") {
+		if pos := strings.Index(line, ":"); pos != -1 && strings.EqualFold(strings.TrimSpace(line[:pos]), "Plural-Forms") {
+			pluralForms = line[pos+1:]
+		}
+	}
+
+	if pluralForms == "" || p.rule == nil {
+		return nil
+	}
+
+	nplurals := -1
+	expression := ""
+	for _, field := range strings.Split(pluralForms, ";") {
+		pos := strings.Index(field, "=")
+		if pos == -1 {
+			continue
+		}
+
+		switch strings.TrimSpace(field[:pos]) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(field[pos+1:]))
+			if err == nil && n > 0 {
+				nplurals = n
+			}
+		case "plural":
+			expression = field[pos+1:]
+		}
+	}
+
+	if nplurals == -1 || expression == "" {
+		return translatorError{message: "invalid Plural-Forms: " + strings.TrimSpace(pluralForms)}
+	}
+
+	plural, err := compileGettextPlural(expression)
+	if err != nil {
+		return err
+	}
+
+	p.forms = map[pluralCategory]int{}
+	for n := uint64(0); n < gettextPluralSamples; n++ {
+		category := p.rule(newPluralOperands(float64(n)))
+		if _, ok := p.forms[category]; ok {
+			continue
+		}
+
+		if form := plural(n); form < uint64(nplurals) {
+			p.forms[category] = int(form)
+		}
+	}
+
+	return nil
+}
+
+// add adds the numbered plural forms of a gettext message to the variants map,
+// as a variant for each of the locale's plural categories. Categories use the
+// form the catalog's Plural-Forms give their numbers, or, without a
+// Plural-Forms header, the form in the same position as the category. Gettext
+// often leaves out the forms only used for fractions, like Russian's "other",
+// so categories without a form use the last one. Messages where every form is
+// untranslated are skipped.
+func (p *gettextPlurals) add(key string, forms []string, variants map[string]map[string]string) error {
+	translated := false
+	for _, form := range forms {
+		if form != "" {
+			translated = true
+		}
+	}
+
+	if !translated {
+		return nil
+	}
+
+	if p.forms == nil && len(forms) > len(p.categories) {
+		return translatorError{message: "can't load messages: " + key + " has " + strconv.Itoa(len(forms)) +
+			" plural forms, but the locale only has " + strconv.Itoa(len(p.categories))}
+	}
+
+	variant := make(map[string]string, len(p.categories))
+	for position, category := range p.categories {
+		index := position
+		if p.forms != nil {
+			index = len(forms) - 1
+			if form, ok := p.forms[category]; ok {
+				index = form
+			}
+		}
+
+		if index < len(forms) {
+			variant[category.String()] = forms[index]
+		} else {
+			variant[category.String()] = forms[len(forms)-1]
+		}
+	}
+
+	variants[key] = variant
+	return nil
+}
+
+// gettextPluralParser parses a gettext Plural-Forms expression, which uses
+// C's syntax and operator precedence
+type gettextPluralParser struct {
+	expression string
+	pos        int
+}
+
+// compileGettextPlural compiles a gettext Plural-Forms expression, like
+// "n != 1" or "n%10==1 && n%100!=11 ? 0 : 1"
+func compileGettextPlural(expression string) (plural gettextPluralExpression, err error) {
+	p := &gettextPluralParser{expression: expression}
+
+	plural, err = p.parseTernary()
+	if err == nil && p.peek() != "" {
+		err = p.errorf("unexpected " + p.peek())
+	}
+	if err != nil {
+		plural = nil
+	}
+	return
+}
+
+// errorf returns an error for an invalid expression
+func (p *gettextPluralParser) errorf(message string) error {
+	return translatorError{message: "invalid Plural-Forms expression " + strconv.Quote(strings.TrimSpace(p.expression)) + ": " + message}
+}
+
+// peek returns the next token without reading it - an operator, a number, "n",
+// or "" at the end of the expression
+func (p *gettextPluralParser) peek() string {
+	for p.pos < len(p.expression) && strings.ContainsRune(" \t\r\n", rune(p.expression[p.pos])) {
+		p.pos++
+	}
+	if p.pos == len(p.expression) {
+		return ""
+	}
+
+	rest := p.expression[p.pos:]
+	for _, operator := range []string{"||", "&&", "==", "!=", "<=", ">="} {
+		if strings.HasPrefix(rest, operator) {
+			return operator
+		}
+	}
+
+	end := 0
+	for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+		end++
+	}
+	if end > 0 {
+		return rest[:end]
+	}
+
+	return rest[:1]
+}
+
+// next reads the next token
+func (p *gettextPluralParser) next() string {
+	token := p.peek()
+	p.pos += len(token)
+	return token
+}
+
+// parseTernary parses a conditional expression, "a ? b : c"
+func (p *gettextPluralParser) parseTernary() (gettextPluralExpression, error) {
+	condition, err := p.parseBinary(0)
+	if err != nil || p.peek() != "?" {
+		return condition, err
+	}
+	p.next()
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if p.next() != ":" {
+		return nil, p.errorf("expected :")
+	}
+
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n uint64) uint64 {
+		if condition(n) != 0 {
+			return then(n)
+		}
+		return otherwise(n)
+	}, nil
+}
+
+// gettextPluralOperators lists the binary operators from the lowest
+// precedence to the highest
+var gettextPluralOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// parseBinary parses a chain of binary operators of a precedence level, and
+// of the higher levels
+func (p *gettextPluralParser) parseBinary(level int) (gettextPluralExpression, error) {
+	if level == len(gettextPluralOperators) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		operator := p.peek()
+		found := false
+		for _, o := range gettextPluralOperators[level] {
+			if operator == o {
+				found = true
+			}
+		}
+		if !found {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = gettextPluralBinary(operator, left, right)
+	}
+}
+
+// gettextPluralBinary returns an expression which applies a binary operator.
+// Division by zero is 0, rather than a panic.
+func gettextPluralBinary(operator string, left, right gettextPluralExpression) gettextPluralExpression {
+	boolean := func(b bool) uint64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+
+	return func(n uint64) uint64 {
+		a, b := left(n), right(n)
+		switch operator {
+		case "||":
+			return boolean(a != 0 || b != 0)
+		case "&&":
+			return boolean(a != 0 && b != 0)
+		case "==":
+			return boolean(a == b)
+		case "!=":
+			return boolean(a != b)
+		case "<":
+			return boolean(a < b)
+		case "<=":
+			return boolean(a <= b)
+		case ">":
+			return boolean(a > b)
+		case ">=":
+			return boolean(a >= b)
+		case "+":
+			return a + b
+		case "-":
+			return a - b
+		case "*":
+			return a * b
+		case "/":
+			if b == 0 {
+				return 0
+			}
+			return a / b
+		}
+
+		if b == 0 {
+			return 0
+		}
+		return a % b
+	}
+}
+
+// parseUnary parses "!", "n", a number, or an expression in parentheses
+func (p *gettextPluralParser) parseUnary() (gettextPluralExpression, error) {
+	token := p.next()
+
+	switch {
+	case token == "!":
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n uint64) uint64 {
+			if operand(n) == 0 {
+				return 1
+			}
+			return 0
+		}, nil
+
+	case token == "n":
+		return func(n uint64) uint64 { return n }, nil
+
+	case token == "(":
+		inner, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, p.errorf("expected )")
+		}
+		return inner, nil
+
+	case token != "" && token[0] >= '0' && token[0] <= '9':
+		value, err := strconv.ParseUint(token, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number " + token)
+		}
+		return func(n uint64) uint64 { return value }, nil
+
+	case token == "":
+		return nil, p.errorf("unexpected end")
+	}
+
+	return nil, p.errorf("unexpected " + token)
+}
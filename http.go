@@ -0,0 +1,443 @@
+package i18n
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HTTPSource is a MessageSource which fetches messages bundles from a
+// translation server. The bundle for a locale is fetched from the source's URL
+// with "{locale}" replaced by the locale, and is parsed in the format for the
+// URL's extension, the same way as a messages file. A 404 response means the
+// server has no messages for the locale. Only so many of those are
+// remembered, since requests can ask for any locale.
+//
+// Bundles are kept for as long as the server's Cache-Control (or Expires)
+// header allows, but at least for the source's MinFreshness, and are then
+// revalidated, using If-None-Match if the server sent an ETag.
+//
+// If the source has a cache directory, the last good bundle for each locale is
+// saved there, and used whenever the server can't be reached - including when
+// a service starts up while the server is down. Responses with
+// "Cache-Control: no-store" aren't saved.
+type HTTPSource struct {
+	// Client is the HTTP client used to fetch bundles. A client with a
+	// timeout of DefaultHTTPTimeout is used if it's nil.
+	Client *http.Client
+
+	// MinFreshness is how long a fetched bundle is kept before it's
+	// revalidated, even if the server's caching headers allow less, so that a
+	// server without them isn't asked on every lookup. NewHTTPSource sets it to
+	// DefaultHTTPMinFreshness.
+	MinFreshness time.Duration
+
+	url      string
+	cacheDir string
+
+	mutex    sync.Mutex
+	bundles  map[string]*httpBundle
+	missing  map[string]time.Time
+	fetching map[string]*httpFetch
+}
+
+const (
+	// DefaultHTTPTimeout is the timeout for fetching a bundle when an
+	// HTTPSource has no Client
+	DefaultHTTPTimeout = 30 * time.Second
+
+	// DefaultHTTPMinFreshness is the MinFreshness of a new HTTPSource
+	DefaultHTTPMinFreshness = time.Minute
+
+	// maxHTTPMissing is how many locales the server has no bundles for are
+	// remembered. Requests can ask for any locale, so the ones the server
+	// doesn't have aren't all kept.
+	maxHTTPMissing = 1000
+)
+
+// defaultHTTPClient is the client used by HTTPSources without a Client
+var defaultHTTPClient = &http.Client{Timeout: DefaultHTTPTimeout}
+
+// httpBundle is a messages bundle fetched from the server, or loaded from the
+// cache directory
+type httpBundle struct {
+	found    bool
+	contents []byte
+	etag     string
+	expires  time.Time
+}
+
+// httpFetch is the lock held while a locale's bundle is fetched, and how many
+// lookups are using it, so it can be removed when the last one is done
+type httpFetch struct {
+	sync.Mutex
+	users int
+}
+
+// NewHTTPSource returns an HTTPSource which fetches bundles from a URL like
+// "https://translations.example.com/bundles/{locale}.json". The cache
+// directory is created when the first bundle is saved to it. Pass an empty
+// cache directory to keep bundles in memory only.
+func NewHTTPSource(rawURL string, cacheDir string) *HTTPSource {
+	return &HTTPSource{
+		MinFreshness: DefaultHTTPMinFreshness,
+		url:          rawURL,
+		cacheDir:     cacheDir,
+		bundles:      map[string]*httpBundle{},
+		missing:      map[string]time.Time{},
+		fetching:     map[string]*httpFetch{},
+	}
+}
+
+// Messages returns the messages in a locale's bundle, fetching it if the
+// cached copy is missing or stale. If the server can't be reached, the last
+// good copy of the bundle is used, and the error is returned along with its
+// messages.
+func (s *HTTPSource) Messages(locale string, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error) {
+	b, errors := s.fetch(locale)
+	if b == nil || !b.found {
+		return
+	}
+
+	newMessages := make(map[string]string)
+	newVariants := make(map[string]map[string]string)
+
+	err := parseMessages(s.ext(), b.contents, rules, newMessages, newVariants)
+	if err != nil {
+		errors = append(errors, translatorError{message: "can't parse messages bundle " + locale + ": " + err.Error()})
+		return
+	}
+
+	messages = newMessages
+	variants = newVariants
+	return
+}
+
+// HasMessages returns whether the server has a bundle for a locale, fetching
+// it if needed
+func (s *HTTPSource) HasMessages(locale string) (exists bool, errors []error) {
+	b, errors := s.fetch(locale)
+	exists = b != nil && b.found
+	return
+}
+
+// fetch returns the bundle for a locale, fetching or revalidating it if its
+// cached copy is stale. If the server can't be reached, the last good copy is
+// returned along with the error. Only one request per locale is made at a
+// time, and the source's mutex isn't held while it's made, so lookups of other
+// locales aren't held up by a slow server.
+func (s *HTTPSource) fetch(locale string) (b *httpBundle, errors []error) {
+	s.mutex.Lock()
+	b = s.bundle(locale)
+	if b != nil && time.Now().Before(b.expires) {
+		s.mutex.Unlock()
+		return
+	}
+
+	fetching := s.fetching[locale]
+	if fetching == nil {
+		fetching = &httpFetch{}
+		s.fetching[locale] = fetching
+	}
+	fetching.users++
+	s.mutex.Unlock()
+
+	fetching.Lock()
+	defer s.doneFetching(locale, fetching)
+
+	// another request for this locale may have finished while this one was
+	// waiting
+	s.mutex.Lock()
+	b = s.bundle(locale)
+	s.mutex.Unlock()
+
+	if b == nil {
+		b = s.loadCached(locale)
+		if b != nil {
+			s.setBundle(locale, b)
+		}
+	}
+
+	if b != nil && time.Now().Before(b.expires) {
+		return
+	}
+
+	req, err := http.NewRequest("GET", s.localeURL(locale), nil)
+	if err != nil {
+		errors = append(errors, translatorError{message: "can't fetch messages bundle " + locale + ": " + err.Error()})
+		return
+	}
+
+	if b != nil && b.found && b.etag != "" {
+		req.Header.Set("If-None-Match", b.etag)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = defaultHTTPClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		errors = append(errors, translatorError{message: "can't fetch messages bundle " + locale + ": " + err.Error()})
+		return
+	}
+	defer resp.Body.Close()
+
+	expires, store := cacheControl(resp.Header)
+	if minExpires := time.Now().Add(s.MinFreshness); expires.Before(minExpires) {
+		expires = minExpires
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && b != nil && b.found:
+		// bundles are shared with concurrent lookups, so a revalidated one
+		// is replaced rather than changed
+		revalidated := *b
+		revalidated.expires = expires
+		b = &revalidated
+
+	case resp.StatusCode == http.StatusOK:
+		contents, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			errors = append(errors, translatorError{message: "can't read messages bundle " + locale + ": " + err.Error()})
+			return
+		}
+
+		b = &httpBundle{
+			found:    true,
+			contents: contents,
+			etag:     resp.Header.Get("ETag"),
+			expires:  expires,
+		}
+
+		if store {
+			if err := s.saveCached(locale, b); err != nil {
+				errors = append(errors, err)
+			}
+		}
+
+	case resp.StatusCode == http.StatusNotFound:
+		b = &httpBundle{expires: expires}
+		s.removeCached(locale)
+
+	default:
+		errors = append(errors, translatorError{message: "can't fetch messages bundle " + locale + ": " + resp.Status})
+		return
+	}
+
+	s.setBundle(locale, b)
+	return
+}
+
+// doneFetching releases a locale's fetch lock, and removes it once no lookups
+// are using it
+func (s *HTTPSource) doneFetching(locale string, fetching *httpFetch) {
+	fetching.Unlock()
+
+	s.mutex.Lock()
+	fetching.users--
+	if fetching.users == 0 {
+		delete(s.fetching, locale)
+	}
+	s.mutex.Unlock()
+}
+
+// bundle returns the current bundle for a locale, or nil if there isn't one.
+// The source's mutex must be held.
+func (s *HTTPSource) bundle(locale string) *httpBundle {
+	if b, ok := s.bundles[locale]; ok {
+		return b
+	}
+	if expires, ok := s.missing[locale]; ok {
+		return &httpBundle{expires: expires}
+	}
+	return nil
+}
+
+// setBundle sets the current bundle for a locale. Locales the server has no
+// bundle for are only remembered until they expire, and only up to
+// maxHTTPMissing of them.
+func (s *HTTPSource) setBundle(locale string, b *httpBundle) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if b.found {
+		s.bundles[locale] = b
+		delete(s.missing, locale)
+		return
+	}
+
+	delete(s.bundles, locale)
+
+	if len(s.missing) >= maxHTTPMissing {
+		now := time.Now()
+		for missing, expires := range s.missing {
+			if !now.Before(expires) {
+				delete(s.missing, missing)
+			}
+		}
+	}
+
+	if len(s.missing) < maxHTTPMissing {
+		s.missing[locale] = b.expires
+	}
+}
+
+// localeURL returns the URL of a locale's bundle
+func (s *HTTPSource) localeURL(locale string) string {
+	return strings.Replace(s.url, "{locale}", url.PathEscape(locale), -1)
+}
+
+// ext returns the extension of the source's URL, which decides the format of
+// its bundles
+func (s *HTTPSource) ext() string {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return path.Ext(s.url)
+	}
+	return path.Ext(u.Path)
+}
+
+// cacheFile returns the path of a locale's last good bundle in the cache
+// directory, or "" if it can't be cached. The bundle's ETag is saved next to
+// it, in a file with ".etag" added to the name.
+func (s *HTTPSource) cacheFile(locale string) string {
+	if s.cacheDir == "" || locale == "" || locale != filepath.Base(locale) || strings.HasPrefix(locale, ".") {
+		return ""
+	}
+	return filepath.Join(s.cacheDir, locale+s.ext())
+}
+
+// loadCached loads a locale's last good bundle from the cache directory. It
+// returns nil if there isn't one. The bundle has already expired, so that it's
+// revalidated before it's used, unless the server can't be reached.
+func (s *HTTPSource) loadCached(locale string) *httpBundle {
+	file := s.cacheFile(locale)
+	if file == "" {
+		return nil
+	}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+
+	etag, _ := ioutil.ReadFile(file + ".etag")
+
+	return &httpBundle{
+		found:    true,
+		contents: contents,
+		etag:     string(etag),
+	}
+}
+
+// saveCached saves a locale's bundle to the cache directory. The old ETag is
+// removed first, so a partly saved bundle is never revalidated with it.
+func (s *HTTPSource) saveCached(locale string, b *httpBundle) error {
+	file := s.cacheFile(locale)
+	if file == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(s.cacheDir, os.FileMode(0755))
+	if err != nil {
+		return translatorError{message: "can't create messages cache directory: " + err.Error()}
+	}
+
+	os.Remove(file + ".etag")
+
+	err = writeFileAtomic(file, b.contents)
+	if err == nil && b.etag != "" {
+		err = writeFileAtomic(file+".etag", []byte(b.etag))
+	}
+
+	if err != nil {
+		return translatorError{message: "can't save messages bundle " + locale + ": " + err.Error()}
+	}
+
+	return nil
+}
+
+// removeCached removes a locale's bundle from the cache directory
+func (s *HTTPSource) removeCached(locale string) {
+	file := s.cacheFile(locale)
+	if file == "" {
+		return
+	}
+
+	os.Remove(file + ".etag")
+	os.Remove(file)
+}
+
+// writeFileAtomic writes a file by writing a temporary file in the same
+// directory and renaming it, so readers never see a partly written file
+func writeFileAtomic(file string, contents []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(contents)
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), os.FileMode(0644))
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file)
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// cacheControl returns when a response expires, and whether it may be stored,
+// according to its Cache-Control and Expires headers. A response without them
+// expires immediately, so it's revalidated the next time it's needed.
+func cacheControl(header http.Header) (expires time.Time, store bool) {
+	now := time.Now()
+	expires = now
+	store = true
+
+	maxAge := -1
+	noCache := false
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store":
+			store = false
+			noCache = true
+		case directive == "no-cache":
+			noCache = true
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.Trim(directive[len("max-age="):], `"`))
+			if err == nil && seconds >= 0 {
+				maxAge = seconds
+			}
+		}
+	}
+
+	switch {
+	case noCache:
+	case maxAge >= 0:
+		expires = now.Add(time.Duration(maxAge) * time.Second)
+	case header.Get("Expires") != "":
+		if t, err := http.ParseTime(header.Get("Expires")); err == nil {
+			expires = t
+		}
+	}
+
+	return
+}
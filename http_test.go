@@ -0,0 +1,382 @@
+package i18n
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// bundleServer is a translation server for testing HTTPSource, which counts
+// the requests it gets
+type bundleServer struct {
+	mutex        sync.Mutex
+	bundles      map[string]string
+	etags        map[string]string
+	cacheControl string
+	requests     int
+	notModified  int
+	failing      bool
+}
+
+func (s *bundleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests++
+	if s.failing {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	if s.cacheControl != "" {
+		w.Header().Set("Cache-Control", s.cacheControl)
+	}
+
+	bundle, ok := s.bundles[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if etag := s.etags[r.URL.Path]; etag != "" {
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.Write([]byte(bundle))
+}
+
+// counts returns the number of requests the server has gotten, and how many of
+// them were answered with 304 Not Modified
+func (s *bundleServer) counts() (requests int, notModified int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests, s.notModified
+}
+
+func (s *bundleServer) set(f func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	f()
+}
+
+func (s *MySuite) TestHTTPSource(c *C) {
+	server := &bundleServer{
+		bundles: map[string]string{
+			"/bundles/en.json": `{"WELCOME": "Welcome!", "ITEM_COUNT": {"one": "{n} item", "other": "{n} items"}}`,
+			"/bundles/fr.json": `{"WELCOME": `,
+		},
+		etags: map[string]string{"/bundles/en.json": `"v1"`},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	cacheDir := c.MkDir() + "/cache"
+	source := NewHTTPSource(ts.URL+"/bundles/{locale}.json", cacheDir)
+	source.MinFreshness = 0
+
+	exists, errors := source.HasMessages("en")
+	c.Check(exists, Equals, true)
+	c.Check(errors, HasLen, 0)
+
+	exists, errors = source.HasMessages("de")
+	c.Check(exists, Equals, false)
+	c.Check(errors, HasLen, 0)
+
+	// without caching headers, the bundle is revalidated with its ETag
+	messages, variants, errors := source.Messages("en", nil)
+	c.Check(errors, HasLen, 0)
+	c.Check(messages["WELCOME"], Equals, "Welcome!")
+	c.Check(variants["ITEM_COUNT"], DeepEquals, map[string]string{"one": "{n} item", "other": "{n} items"})
+	requests, notModified := server.counts()
+	c.Check(requests, Equals, 3)
+	c.Check(notModified, Equals, 1)
+
+	// the last good bundle is saved with its ETag
+	contents, err := ioutil.ReadFile(cacheDir + "/en.json")
+	c.Check(err, IsNil)
+	c.Check(string(contents), Equals, `{"WELCOME": "Welcome!", "ITEM_COUNT": {"one": "{n} item", "other": "{n} items"}}`)
+
+	etag, err := ioutil.ReadFile(cacheDir + "/en.json.etag")
+	c.Check(err, IsNil)
+	c.Check(string(etag), Equals, `"v1"`)
+
+	// bundles that can't be parsed have no messages
+	messages, variants, errors = source.Messages("fr", nil)
+	c.Check(errors, HasLen, 1)
+	c.Check(messages, IsNil)
+	c.Check(variants, IsNil)
+
+	// a changed bundle is fetched again
+	server.set(func() {
+		server.bundles["/bundles/en.json"] = `{"WELCOME": "Welcome back!"}`
+		server.etags["/bundles/en.json"] = `"v2"`
+	})
+
+	messages, _, errors = source.Messages("en", nil)
+	c.Check(errors, HasLen, 0)
+	c.Check(messages["WELCOME"], Equals, "Welcome back!")
+
+	etag, _ = ioutil.ReadFile(cacheDir + "/en.json.etag")
+	c.Check(string(etag), Equals, `"v2"`)
+
+	// when the server fails, the last good bundle is used
+	server.set(func() { server.failing = true })
+
+	messages, _, errors = source.Messages("en", nil)
+	c.Check(errors, HasLen, 1)
+	c.Check(messages["WELCOME"], Equals, "Welcome back!")
+
+	// including by a new source, like after a restart
+	ts.Close()
+	restarted := NewHTTPSource(ts.URL+"/bundles/{locale}.json", cacheDir)
+
+	messages, _, errors = restarted.Messages("en", nil)
+	c.Check(errors, HasLen, 1)
+	c.Check(messages["WELCOME"], Equals, "Welcome back!")
+
+	exists, _ = restarted.HasMessages("de")
+	c.Check(exists, Equals, false)
+}
+
+func (s *MySuite) TestHTTPSourceCacheControl(c *C) {
+	server := &bundleServer{
+		bundles:      map[string]string{"/en.yaml": `WELCOME: "Welcome!"`},
+		cacheControl: "public, max-age=3600",
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	cacheDir := c.MkDir()
+	source := NewHTTPSource(ts.URL+"/{locale}.yaml", cacheDir)
+
+	// fresh bundles aren't fetched again
+	for i := 0; i < 3; i++ {
+		messages, _, errors := source.Messages("en", nil)
+		c.Check(errors, HasLen, 0)
+		c.Check(messages["WELCOME"], Equals, "Welcome!")
+	}
+	requests, _ := server.counts()
+	c.Check(requests, Equals, 1)
+
+	// neither are missing ones
+	source.HasMessages("de")
+	source.HasMessages("de")
+	requests, _ = server.counts()
+	c.Check(requests, Equals, 2)
+
+	// no-store bundles aren't saved
+	server.set(func() { server.cacheControl = "no-store" })
+
+	source = NewHTTPSource(ts.URL+"/{locale}.yaml", c.MkDir())
+	source.MinFreshness = 0
+	source.Messages("en", nil)
+	source.Messages("en", nil)
+	requests, _ = server.counts()
+	c.Check(requests, Equals, 4)
+
+	_, err := ioutil.ReadFile(source.cacheDir + "/en.yaml")
+	c.Check(err, NotNil)
+
+	// a bundle that's been removed from the server is removed from the cache
+	server.set(func() { delete(server.bundles, "/en.yaml") })
+
+	_, err = ioutil.ReadFile(cacheDir + "/en.yaml")
+	c.Check(err, IsNil)
+
+	source = NewHTTPSource(ts.URL+"/{locale}.yaml", cacheDir)
+	exists, errors := source.HasMessages("en")
+	c.Check(exists, Equals, false)
+	c.Check(errors, HasLen, 0)
+
+	_, err = ioutil.ReadFile(cacheDir + "/en.yaml")
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestHTTPSourceMinFreshness(c *C) {
+	server := &bundleServer{
+		bundles: map[string]string{"/en.json": `{"WELCOME": "Welcome!"}`},
+		etags:   map[string]string{"/en.json": `"v1"`},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	source := NewHTTPSource(ts.URL+"/{locale}.json", "")
+	c.Check(source.MinFreshness, Equals, DefaultHTTPMinFreshness)
+
+	// bundles without caching headers aren't revalidated on every lookup
+	for i := 0; i < 3; i++ {
+		messages, _, errors := source.Messages("en", nil)
+		c.Check(errors, HasLen, 0)
+		c.Check(messages["WELCOME"], Equals, "Welcome!")
+	}
+	requests, _ := server.counts()
+	c.Check(requests, Equals, 1)
+
+	// but they are once they've been kept for MinFreshness
+	source.MinFreshness = 0
+	source.mutex.Lock()
+	source.bundles["en"].expires = time.Now()
+	source.mutex.Unlock()
+
+	source.Messages("en", nil)
+	source.Messages("en", nil)
+	requests, notModified := server.counts()
+	c.Check(requests, Equals, 3)
+	c.Check(notModified, Equals, 2)
+}
+
+func (s *MySuite) TestHTTPSourceMissing(c *C) {
+	server := &bundleServer{
+		bundles: map[string]string{"/en.json": `{"WELCOME": "Welcome!"}`},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	source := NewHTTPSource(ts.URL+"/{locale}.json", "")
+
+	// missing locales are remembered, and nothing is kept for the fetches
+	for i := 0; i < 2; i++ {
+		exists, errors := source.HasMessages("checkout")
+		c.Check(exists, Equals, false)
+		c.Check(errors, HasLen, 0)
+	}
+	requests, _ := server.counts()
+	c.Check(requests, Equals, 1)
+	c.Check(source.bundles, HasLen, 0)
+	c.Check(source.missing, HasLen, 1)
+	c.Check(source.fetching, HasLen, 0)
+
+	// but only so many of them
+	source.mutex.Lock()
+	for i := len(source.missing); i < maxHTTPMissing; i++ {
+		source.missing["x"+strconv.Itoa(i)] = time.Now().Add(time.Hour)
+	}
+	source.mutex.Unlock()
+
+	exists, _ := source.HasMessages("api")
+	c.Check(exists, Equals, false)
+	c.Check(source.missing, HasLen, maxHTTPMissing)
+
+	// expired ones make room for new ones
+	source.mutex.Lock()
+	source.missing["x1"] = time.Now()
+	source.mutex.Unlock()
+
+	source.HasMessages("api")
+	c.Check(source.missing, HasLen, maxHTTPMissing)
+	_, ok := source.missing["api"]
+	c.Check(ok, Equals, true)
+
+	exists, _ = source.HasMessages("en")
+	c.Check(exists, Equals, true)
+}
+
+func (s *MySuite) TestHTTPSourceConcurrent(c *C) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow.json" {
+			<-release
+		}
+		w.Write([]byte(`{"WELCOME": "Welcome!"}`))
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	source := NewHTTPSource(ts.URL+"/{locale}.json", "")
+	source.Client = &http.Client{Timeout: 5 * time.Second}
+
+	go source.HasMessages("slow")
+
+	// a slow server for one locale doesn't hold up the others
+	done := make(chan bool)
+	go func() {
+		exists, _ := source.HasMessages("en")
+		done <- exists
+	}()
+
+	select {
+	case exists := <-done:
+		c.Check(exists, Equals, true)
+	case <-time.After(2 * time.Second):
+		c.Error("fetching en waited for the slow locale")
+	}
+}
+
+func (s *MySuite) TestCacheControl(c *C) {
+	now := time.Now()
+
+	expires, store := cacheControl(http.Header{"Cache-Control": {"max-age=60"}})
+	c.Check(store, Equals, true)
+	c.Check(expires.Sub(now) > 59*time.Second, Equals, true)
+
+	expires, store = cacheControl(http.Header{"Cache-Control": {"max-age=60, no-cache"}})
+	c.Check(store, Equals, true)
+	c.Check(expires.After(time.Now()), Equals, false)
+
+	_, store = cacheControl(http.Header{"Cache-Control": {"No-Store"}})
+	c.Check(store, Equals, false)
+
+	expires, _ = cacheControl(http.Header{"Expires": {"Mon, 02 Jan 2006 15:04:05 GMT"}})
+	c.Check(expires.Year(), Equals, 2006)
+
+	expires, _ = cacheControl(http.Header{})
+	c.Check(expires.After(time.Now()), Equals, false)
+}
+
+func (s *MySuite) TestHTTPSourceFactory(c *C) {
+	server := &bundleServer{
+		bundles: map[string]string{
+			"/en.json":    `{"WELCOME": "Welcome!", "ITEM_COUNT": {"one": "{n} item", "other": "{n} items"}}`,
+			"/en-GB.json": `{"WELCOME": "Welcome, mate!"}`,
+		},
+		cacheControl: "max-age=60",
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	source := NewHTTPSource(ts.URL+"/{locale}.json", "")
+	f, errors := NewTranslatorFactoryFromSources(
+		[]RulesSource{NewDirSource("data/rules")},
+		[]MessageSource{source},
+		"en",
+	)
+	c.Check(errors, HasLen, 0)
+	c.Assert(f, NotNil)
+
+	tGB, errors := f.GetTranslator("en-GB")
+	c.Check(errors, HasLen, 0)
+
+	t, _ := tGB.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome, mate!")
+
+	t, _ = tGB.Pluralize("ITEM_COUNT", 2, "2")
+	c.Check(t, Equals, "2 items")
+
+	// cached Translators are returned without asking the server, even when
+	// its bundles have to be revalidated
+	server.set(func() { server.cacheControl = "no-cache" })
+	source.MinFreshness = 0
+	source.mutex.Lock()
+	for _, b := range source.bundles {
+		b.expires = time.Now()
+	}
+	source.mutex.Unlock()
+
+	requests, _ := server.counts()
+	for i := 0; i < 3; i++ {
+		f.GetTranslator("en-GB")
+		f.GetTranslator("en")
+	}
+	after, _ := server.counts()
+	c.Check(after, Equals, requests)
+}
@@ -2,11 +2,14 @@ package i18n
 
 import (
 	// standard library
-	"io/ioutil"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
 	"os"
 	"path"
-	"path/filepath"
 	"strings"
+	"sync"
 
 	// third party
 	"gopkg.in/yaml.v1"
@@ -15,12 +18,19 @@ import (
 // TranslatorFactory is a struct which contains the info necessary for creating
 // Translator "instances". It also "caches" previously created Translators.
 // Because of this caching, you can request a Translator for a specific locale
-// multiple times and always get a pointer to the same Translator instance.
+// multiple times and always get a pointer to the same Translator instance, until
+// the factory is reloaded. A TranslatorFactory and its Translators are safe for
+// concurrent use by multiple goroutines.
 type TranslatorFactory struct {
-	messagesPaths []string
-	rulesPaths    []string
-	translators   map[string]*Translator
-	fallback      *Translator
+	messagesPaths  []string
+	rulesPaths     []string
+	messageSources []MessageSource
+	rulesSources   []RulesSource
+	translators    map[string]*Translator
+	fallback       *Translator
+	mutex          sync.RWMutex
+	reloadMutex    sync.Mutex
+	listeners      []func(locales []string, errors []error)
 }
 
 // Translator is a struct which contains all the rules and messages necessary
@@ -28,6 +38,7 @@ type TranslatorFactory struct {
 // package is accessed through a Translator instance.
 type Translator struct {
 	messages map[string]string
+	variants map[string]map[string]string
 	locale   string
 	rules    *TranslatorRules
 	fallback *Translator
@@ -36,14 +47,40 @@ type Translator struct {
 // translatorError implements the error interface for use in this package. it
 // keeps an optional reference to a Translator instance, which it uses to
 // include which locale the error occurs with in the error message returned by
-// the Error() method
+// the Error() method. Warnings are errors which don't stop a Translator from
+// working, like a locale without messages files of its own. notFound is set for
+// locales with neither rules nor messages of their own, which still get a
+// Translator that uses their fallbacks.
 type translatorError struct {
 	translator *Translator
 	message    string
+	warning    bool
+	notFound   bool
+}
+
+// catalogFile is a rules or messages file in one of a factory's file systems
+type catalogFile struct {
+	fsys fs.FS
+	name string
 }
 
 var pathSeparator string
 
+// the file extensions of the supported messages and rules file formats, in the
+// order files with the same name are loaded
+var (
+	messagesExtensions = []string{".yaml", ".json", ".po", ".mo"}
+	rulesExtensions    = []string{".yaml", ".json"}
+)
+
+// messageContexts is the key in messages files that groups messages by their
+// context, and messageContextSeparator joins a message's context to its key.
+// The separator is the same one gettext uses for msgctxt.
+const (
+	messageContexts         = "_context"
+	messageContextSeparator = "\x04"
+)
+
 func init() {
 	p := path.Join("a", "b")
 	pathSeparator = p[1 : len(p)-1]
@@ -57,6 +94,22 @@ func (e translatorError) Error() string {
 	return "translator error - " + e.message
 }
 
+// IsWarning returns whether an error returned by this package is only a
+// warning, like a locale having no messages files of its own, which uses its
+// fallback's messages. Other errors are failures to find, load or parse
+// something.
+func IsWarning(err error) bool {
+	e, ok := err.(translatorError)
+	return ok && e.warning
+}
+
+// isNotFound returns whether an error is for a locale with neither rules nor
+// messages of its own
+func isNotFound(err error) bool {
+	e, ok := err.(translatorError)
+	return ok && e.notFound
+}
+
 // NewTranslatorFactory returns a TranslatorFactory instance with the specified
 // paths and fallback locale.  If a fallback locale is specified, it
 // automatically creates the fallback Translator instance. Several errors can
@@ -90,9 +143,17 @@ func (e translatorError) Error() string {
 //
 //  Using the second way allows you to organize your messages into multiple
 //  files.
+//
+// Rules and messages files can be either YAML (*.yaml) or JSON (*.json) files,
+// and both formats can be mixed in the same directory. When there is both a
+// YAML and a JSON file with the same name, the JSON file is loaded last.
+//
+// Messages can also be gettext PO (*.po) or compiled MO (*.mo) files, which
+// are loaded after YAML and JSON files with the same name. A msgctxt is used as
+// the message's context, and the numbered msgstr[n] forms of a plural message
+// are used for the locale's plural categories in order. Fuzzy entries are
+// skipped.
 func NewTranslatorFactory(rulesPaths []string, messagesPaths []string, fallbackLocale string) (f *TranslatorFactory, errors []error) {
-	f = new(TranslatorFactory)
-
 	if len(rulesPaths) == 0 {
 		errors = append(errors, translatorError{message: "rules paths empty"})
 	}
@@ -101,48 +162,131 @@ func NewTranslatorFactory(rulesPaths []string, messagesPaths []string, fallbackL
 		errors = append(errors, translatorError{message: "messages paths empty"})
 	}
 
-	foundRules := fallbackLocale == ""
-	foundMessages := fallbackLocale == ""
-
+	rulesSources := make([]RulesSource, 0, len(rulesPaths))
 	for _, p := range rulesPaths {
 		p = strings.TrimRight(p, pathSeparator)
 		_, err := os.Stat(p)
 		if err != nil {
 			errors = append(errors, translatorError{message: "can't read rules path " + p + ": " + err.Error()})
 		}
-
-		if !foundRules {
-			_, err = os.Stat(p + pathSeparator + fallbackLocale + ".yaml")
-			if err == nil {
-				foundRules = true
-			}
-		}
+		rulesSources = append(rulesSources, NewDirSource(p))
 	}
 
+	messageSources := make([]MessageSource, 0, len(messagesPaths))
 	for _, p := range messagesPaths {
 		p = strings.TrimRight(p, pathSeparator)
 		_, err := os.Stat(p)
 		if err != nil {
 			errors = append(errors, translatorError{message: "can't read messages path " + p + ": " + err.Error()})
 		}
+		messageSources = append(messageSources, NewDirSource(p))
+	}
+
+	f, errs := newTranslatorFactory(rulesSources, messageSources, fallbackLocale)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+
+	f.rulesPaths = rulesPaths
+	f.messagesPaths = messagesPaths
+
+	return
+}
+
+// NewTranslatorFactoryFS returns a TranslatorFactory instance which loads its
+// rules and messages from file systems, rather than from paths on disk. This
+// lets you ship the rules and messages inside your binary with an embed.FS.
+// The file systems are used the same way as the paths passed to
+// NewTranslatorFactory - the files are named after locale codes and are in the
+// root of each file system, and file systems later in the slices override the
+// rules and messages loaded from earlier ones. Use fs.Sub for files that are
+// in a subdirectory.
+//
+//     //go:embed rules messages
+//     var data embed.FS
+//
+//     rules, _ := fs.Sub(data, "rules")
+//     messages, _ := fs.Sub(data, "messages")
+//     f, errs := i18n.NewTranslatorFactoryFS([]fs.FS{rules}, []fs.FS{messages}, "en")
+func NewTranslatorFactoryFS(rulesFS []fs.FS, messagesFS []fs.FS, fallbackLocale string) (f *TranslatorFactory, errors []error) {
+	if len(rulesFS) == 0 {
+		errors = append(errors, translatorError{message: "rules file systems empty"})
+	}
 
+	if len(messagesFS) == 0 {
+		errors = append(errors, translatorError{message: "messages file systems empty"})
+	}
+
+	rulesSources := make([]RulesSource, len(rulesFS))
+	for i, fsys := range rulesFS {
+		rulesSources[i] = NewFSSource(fsys)
+	}
+
+	messageSources := make([]MessageSource, len(messagesFS))
+	for i, fsys := range messagesFS {
+		messageSources[i] = NewFSSource(fsys)
+	}
+
+	f, errs := newTranslatorFactory(rulesSources, messageSources, fallbackLocale)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// NewTranslatorFactoryFromSources returns a TranslatorFactory instance which
+// loads its rules and messages from sources, like a database or a translation
+// service. Sources are layered the same way as the paths passed to
+// NewTranslatorFactory, with sources later in the slices overriding the rules
+// and messages from earlier ones.
+//
+// FSSource loads rules and messages files from a file system, and MemorySource
+// keeps them in memory. Both can be mixed with your own sources - to use the
+// rules that ship with this package and messages from somewhere else:
+//
+//     f, errs := i18n.NewTranslatorFactoryFromSources(
+//         []i18n.RulesSource{i18n.NewDirSource(rulesPath)},
+//         []i18n.MessageSource{dbSource},
+//         "en",
+//     )
+func NewTranslatorFactoryFromSources(rulesSources []RulesSource, messageSources []MessageSource, fallbackLocale string) (f *TranslatorFactory, errors []error) {
+	if len(rulesSources) == 0 {
+		errors = append(errors, translatorError{message: "rules sources empty"})
+	}
+
+	if len(messageSources) == 0 {
+		errors = append(errors, translatorError{message: "message sources empty"})
+	}
+
+	f, errs := newTranslatorFactory(rulesSources, messageSources, fallbackLocale)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// newTranslatorFactory returns a TranslatorFactory instance with the specified
+// rules and message sources, checking that they have rules and messages for
+// the fallback locale and loading its Translator
+func newTranslatorFactory(rulesSources []RulesSource, messageSources []MessageSource, fallbackLocale string) (f *TranslatorFactory, errors []error) {
+	f = new(TranslatorFactory)
+	fallbackLocale = CanonicalLocale(fallbackLocale)
+
+	foundRules := fallbackLocale == ""
+	foundMessages := fallbackLocale == ""
+
+	for _, source := range rulesSources {
+		if !foundRules {
+			rules, _ := source.Rules(fallbackLocale)
+			foundRules = rules != nil
+		}
+	}
+
+	for _, source := range messageSources {
 		if !foundMessages {
-			_, err = os.Stat(p + pathSeparator + fallbackLocale + ".yaml")
-			if err == nil {
-				foundMessages = true
-			} else {
-				info, err := os.Stat(p + pathSeparator + fallbackLocale)
-				if err == nil && info.IsDir() {
-					files, _ := filepath.Glob(p + pathSeparator + fallbackLocale + pathSeparator + "*.yaml")
-					for _, file := range files {
-						_, err = os.Stat(file)
-						if err == nil {
-							foundMessages = true
-							break
-						}
-					}
-				}
-			}
+			foundMessages, _ = source.HasMessages(fallbackLocale)
 		}
 	}
 
@@ -154,8 +298,8 @@ func NewTranslatorFactory(rulesPaths []string, messagesPaths []string, fallbackL
 		errors = append(errors, translatorError{message: "found no messages for fallback locale"})
 	}
 
-	f.rulesPaths = rulesPaths
-	f.messagesPaths = messagesPaths
+	f.rulesSources = rulesSources
+	f.messageSources = messageSources
 	f.translators = map[string]*Translator{}
 
 	// load and check the fallback locale
@@ -172,25 +316,32 @@ func NewTranslatorFactory(rulesPaths []string, messagesPaths []string, fallbackL
 
 // GetTranslator returns an Translator instance for the requested locale. If you
 // request the same locale multiple times, a pointed to the same Translator will
-// be returned each time.
+// be returned each time, until the factory is reloaded. The locale code is
+// canonicalized first (see CanonicalLocale), so "en_gb" and "en-GB" get the
+// same Translator, and its Locale is "en-GB". Less specific locales are
+// loaded as fallbacks, including ones with scripts - "zh-Hant" and "zh" for
+// "zh-TW", which is canonicalized to "zh-Hant-TW", and "zh-Hans" and "zh" for
+// "zh-CN". Extensions and variants are left out of the fallbacks, so
+// "en-US-POSIX" falls back to "en-US".
 func (f *TranslatorFactory) GetTranslator(localeCode string) (t *Translator, errors []error) {
+	localeCode = CanonicalLocale(localeCode)
 
-	fallback := f.getFallback(localeCode)
-
-	if t, ok := f.translators[localeCode]; ok {
+	f.mutex.RLock()
+	t, ok := f.translators[localeCode]
+	f.mutex.RUnlock()
+	if ok {
 		return t, nil
 	}
 
+	fallback := f.getFallback(localeCode)
+
 	exists, errs := f.LocaleExists(localeCode)
-	if !exists {
-		errors = append(errors, translatorError{message: "could not find rules and messages for locale " + localeCode})
-	}
 	for _, e := range errs {
 		errors = append(errors, e)
 	}
 
 	rules := new(TranslatorRules)
-	files := []string{}
+	locales := []string{}
 
 	// TODO: the rules loading logic is fairly complex, and there are some
 	// specific cases we are not testing for yet. We need to test that the
@@ -199,35 +350,42 @@ func (f *TranslatorFactory) GetTranslator(localeCode string) (t *Translator, err
 
 	// the load the base (default) rule values
 	// the step above
-	for _, p := range f.rulesPaths {
-		p = strings.TrimRight(p, pathSeparator)
-		files = append(files, p+pathSeparator+"root.yaml")
-	}
+	locales = append(locales, "root")
 
 	// load less specific fallback locale rules
-	parts := strings.Split(localeCode, "-")
-	if len(parts) > 1 {
-		for i, _ := range parts {
-			fb := strings.Join(parts[0:i+1], "-")
-			for _, p := range f.rulesPaths {
-				p = strings.TrimRight(p, pathSeparator)
-				files = append(files, p+pathSeparator+fb+".yaml")
+	parents := localeParents(localeCode)
+	for i := len(parents) - 1; i >= 0; i-- {
+		locales = append(locales, parents[i])
+	}
+
+	// finally load files for this specific locale
+	locales = append(locales, localeCode)
+
+	for _, l := range locales {
+		for _, source := range f.rulesSources {
+			localeRules, errs := source.Rules(l)
+			for _, err := range errs {
+				errors = append(errors, err)
+			}
+
+			if localeRules != nil {
+				rules.merge(localeRules)
+				exists = exists || l == localeCode
 			}
 		}
 	}
 
-	// finally load files for this specific locale
-	for _, p := range f.rulesPaths {
-		p = strings.TrimRight(p, pathSeparator)
-		files = append(files, p+pathSeparator+localeCode+".yaml")
+	// locales with only rules, like "en-GB", use their fallback's messages
+	if !exists {
+		errors = append(errors, translatorError{message: "could not find rules and messages for locale " + localeCode, notFound: true})
 	}
 
-	errs = rules.load(files)
+	errs = rules.compile()
 	for _, err := range errs {
 		errors = append(errors, err)
 	}
 
-	messages, errs := loadMessages(localeCode, f.messagesPaths)
+	messages, variants, errs := loadMessages(localeCode, f.messageSources, rules)
 	for _, err := range errs {
 		errors = append(errors, err)
 	}
@@ -235,10 +393,19 @@ func (f *TranslatorFactory) GetTranslator(localeCode string) (t *Translator, err
 	t = new(Translator)
 	t.locale = localeCode
 	t.messages = messages
+	t.variants = variants
 	t.fallback = fallback
 	t.rules = rules
 
-	f.translators[localeCode] = t
+	// if another goroutine created a Translator for this locale while this
+	// one was loading, use that one, so the same Translator is always returned
+	f.mutex.Lock()
+	if existing, ok := f.translators[localeCode]; ok {
+		t = existing
+	} else {
+		f.translators[localeCode] = t
+	}
+	f.mutex.Unlock()
 
 	return
 }
@@ -248,22 +415,17 @@ func (f *TranslatorFactory) GetTranslator(localeCode string) (t *Translator, err
 // fallback if it exists.
 func (f *TranslatorFactory) getFallback(localeCode string) *Translator {
 
-	if f.fallback != nil && localeCode == f.fallback.locale {
+	f.mutex.RLock()
+	fallback := f.fallback
+	f.mutex.RUnlock()
+
+	if fallback != nil && localeCode == fallback.locale {
 		return nil
 	}
 
-	separator := "-"
-
-	// for a "multipart" locale code, find the most appropriate fallback
-	// start by taking off the last "part"
-	// if you run out of parts, use the factory's fallback
-
-	fallback := f.fallback
-	parts := strings.Split(localeCode, separator)
-	for len(parts) > 1 {
-		parts = parts[0 : len(parts)-1]
-		fb := strings.Join(parts, separator)
-
+	// use the first of the locale's parents with messages (see
+	// localeParents), or the factory's fallback if none of them have any
+	for _, fb := range localeParents(localeCode) {
 		if exists, _ := f.LocaleExists(fb); exists {
 			fallback, _ = f.GetTranslator(fb)
 			break
@@ -273,31 +435,39 @@ func (f *TranslatorFactory) getFallback(localeCode string) *Translator {
 	return fallback
 }
 
-// LocaleExists checks to see if any messages files exist for the requested
-// locale string.
+// LocaleExists checks to see if any message sources have messages for the
+// requested locale string, after canonicalizing it.
 func (f *TranslatorFactory) LocaleExists(localeCode string) (exists bool, errs []error) {
-	for _, p := range f.messagesPaths {
-		p = strings.TrimRight(p, pathSeparator)
-		_, err := os.Stat(p + pathSeparator + localeCode + ".yaml")
-		if err == nil {
+	localeCode = CanonicalLocale(localeCode)
+	for _, source := range f.messageSources {
+		found, sourceErrs := source.HasMessages(localeCode)
+		for _, err := range sourceErrs {
+			errs = append(errs, err)
+		}
+
+		if found {
 			exists = true
 			return
-		} else if !os.IsNotExist(err) {
-			errs = append(errs, translatorError{message: "error getting file info: " + err.Error()})
 		}
+	}
 
-		info, err := os.Stat(p + pathSeparator + localeCode)
-		if err == nil && info.IsDir() {
-			files, _ := filepath.Glob(p + pathSeparator + localeCode + pathSeparator + "*.yaml")
-			for _, file := range files {
-				_, err := os.Stat(file)
-				if err == nil {
-					exists = true
-					return
-				} else if !os.IsNotExist(err) {
-					errs = append(errs, translatorError{message: "error getting file info: " + err.Error()})
-				}
-			}
+	return
+}
+
+// hasRules checks to see if any rules sources have rules for the requested
+// locale itself, after canonicalizing it. GetTranslator counts a locale with
+// rules but no messages, like "en-GB", as existing.
+func (f *TranslatorFactory) hasRules(localeCode string) (exists bool, errs []error) {
+	localeCode = CanonicalLocale(localeCode)
+	for _, source := range f.rulesSources {
+		rules, sourceErrs := source.Rules(localeCode)
+		for _, err := range sourceErrs {
+			errs = append(errs, err)
+		}
+
+		if rules != nil {
+			exists = true
+			return
 		}
 	}
 
@@ -308,6 +478,11 @@ func (f *TranslatorFactory) LocaleExists(localeCode string) (exists bool, errs [
 // requested in the substitutions map. If neither this translator nor its
 // fallback translator (or the fallback's fallback and so on) have a translation
 // for the requested key, and empty string and an error will be returned.
+//
+// Messages are ICU MessageFormat strings, so besides simple {key}
+// placeholders they can contain plural, selectordinal and select arguments,
+// like "{count, plural, one {# item} other {# items}}". Values for plural and
+// selectordinal arguments must be numbers, for example "5" or "1.5".
 func (t *Translator) Translate(key string, substitutions map[string]string) (translation string, errors []error) {
 	if _, ok := t.messages[key]; !ok {
 		if t.fallback != nil && t.fallback != t {
@@ -318,7 +493,77 @@ func (t *Translator) Translate(key string, substitutions map[string]string) (tra
 		return
 	}
 
-	translation, errors = t.substitute(t.messages[key], substitutions)
+	translation, errors = t.formatMessage(t.messages[key], substitutions)
+	return
+}
+
+// TranslateContext returns the translated message for a key in a particular
+// context, for when the same key needs different translations depending on how
+// it's used - like "Open" as a verb on a button and as an adjective describing
+// a store. If this translator doesn't have a translation for the key in the
+// requested context, its translation without a context is used. Substitutions
+// are done just like in the Translate method. If neither this translator nor
+// its fallback translator (or the fallback's fallback and so on) have a
+// translation for the requested key, and empty string and an error will be
+// returned.
+func (t *Translator) TranslateContext(context string, key string, substitutions map[string]string) (translation string, errors []error) {
+	message, ok := t.messages[contextKey(context, key)]
+	if !ok {
+		message, ok = t.messages[key]
+	}
+
+	if !ok {
+		if t.fallback != nil && t.fallback != t {
+			return t.fallback.TranslateContext(context, key, substitutions)
+		}
+
+		errors = append(errors, t.keyNotFound(context, key))
+		return
+	}
+
+	translation, errors = t.formatMessage(message, substitutions)
+	return
+}
+
+// Select returns the translation for a message with named variants, like a
+// message with "female", "male" and "other" variants for each grammatical
+// gender. The variant named by the selector string is used, or the "other"
+// variant if there's no variant with that name. Substitutions are done just
+// like in the Translate method. If neither this translator nor its fallback
+// translator (or the fallback's fallback and so on) have a translation for the
+// requested key, and empty string and an error will be returned.
+func (t *Translator) Select(key string, selector string, substitutions map[string]string) (translation string, errors []error) {
+	_, isMessage := t.messages[key]
+	variants, isVariants := t.variants[key]
+	if !isMessage && !isVariants {
+		if t.fallback != nil && t.fallback != t {
+			return t.fallback.Select(key, selector, substitutions)
+		}
+
+		errors = append(errors, translatorError{translator: t, message: "key not found: " + key})
+		return
+	}
+
+	// a message without variants is used for every selector
+	message := t.messages[key]
+	if isVariants {
+		var ok bool
+		message, ok = variants[selector]
+		if !ok {
+			message, ok = variants[messageFormatOther]
+		}
+
+		if !ok {
+			errors = append(errors, translatorError{translator: t, message: "missing select variant " + selector + ": " + key})
+			return
+		}
+	}
+
+	var errs []error
+	translation, errs = t.formatMessage(message, substitutions)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
 	return
 }
 
@@ -328,22 +573,171 @@ func (t *Translator) Translate(key string, substitutions map[string]string) (tra
 // its fallback translator (or the fallback's fallback and so on) have a
 // translation for the requested key, and empty string and an error will be
 // returned.
+//
+// The plural form also depends on the fraction digits visible in numberStr -
+// in English, "1 star" uses the "one" form, but "1.0 stars" uses "other". This
+// is only the case when numberStr is the same number as the number float64,
+// formatted with the locale's decimal and group symbols, otherwise only the
+// number float64 is used.
+//
+// Plural messages can either be a map of CLDR plural category names (zero,
+// one, two, few, many, other) to message variants, or a single string with
+// the variants separated by "|", in the order of the categories used by the
+// locale. An error is returned if a plural category map is missing any of the
+// categories the locale uses, in which case the "other" variant is used.
 func (t *Translator) Pluralize(key string, number float64, numberStr string) (translation string, errors []error) {
+	return t.pluralize("", key, number, numberStr, false)
+}
+
+// PluralizeContext works just like Pluralize, but for a key in a particular
+// context, like TranslateContext. If this translator doesn't have a plural
+// message for the key in the requested context, its message without a context
+// is used.
+func (t *Translator) PluralizeContext(context string, key string, number float64, numberStr string) (translation string, errors []error) {
+	return t.pluralize(context, key, number, numberStr, false)
+}
+
+// PluralizeOrdinal works just like Pluralize, but chooses the plural form
+// using the locale's ordinal rules rather than its cardinal plural rules. Use
+// it for messages like "1st place" or "take the 2nd left".
+func (t *Translator) PluralizeOrdinal(key string, number float64, numberStr string) (translation string, errors []error) {
+	return t.pluralize("", key, number, numberStr, true)
+}
+
+// PluralizeOrdinalContext works just like PluralizeOrdinal, but for a key in a
+// particular context, like PluralizeContext.
+func (t *Translator) PluralizeOrdinalContext(context string, key string, number float64, numberStr string) (translation string, errors []error) {
+	return t.pluralize(context, key, number, numberStr, true)
+}
+
+// pluralize does the work for Pluralize, PluralizeOrdinal and their context
+// versions
+func (t *Translator) pluralize(context string, key string, number float64, numberStr string, ordinal bool) (translation string, errors []error) {
 
 	// TODO: errors are returned when there isn't a substitution - but it is
 	// valid to not have a substitution in cases where there's only one number
 	// for a single plural form. In these cases, no error should be returned.
 
-	if _, ok := t.messages[key]; !ok {
+	messageKey, ok := t.pluralKey(context, key)
+	if !ok {
 		if t.fallback != nil && t.fallback != t {
-			return t.fallback.Pluralize(key, number, numberStr)
+			return t.fallback.pluralize(context, key, number, numberStr, ordinal)
 		}
 
-		errors = append(errors, translatorError{translator: t, message: "key not found: " + key})
+		errors = append(errors, t.keyNotFound(context, key))
 		return
 	}
 
-	form := (t.rules.PluralRuleFunc)(number)
+	operands := t.rules.pluralOperands(number, numberStr)
+
+	category := (t.rules.pluralOperandsFunc)(operands)
+	categories := t.rules.PluralCategories
+	if ordinal {
+		category = (t.rules.ordinalOperandsFunc)(operands)
+		categories = t.rules.OrdinalCategories
+	}
+
+	message, ok, errors := t.pluralMessage(messageKey, category, categories)
+	if !ok {
+		return
+	}
+
+	var errs []error
+	translation, errs = t.substitute(message, map[string]string{"n": numberStr})
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// PluralizeRange returns the translation for a message containing a plural
+// for a range of numbers, like "1-3 days". The plural form used is chosen from
+// the plural forms of the start and end numbers using the locale's plural
+// ranges. The {start} and {end} placeholders in the message are replaced with
+// the numbers formatted using the locale's decimal format. If neither this
+// translator nor its fallback translator (or the fallback's fallback and so on)
+// have a translation for the requested key, and empty string and an error will
+// be returned.
+func (t *Translator) PluralizeRange(key string, start, end float64) (translation string, errors []error) {
+	return t.pluralizeRange("", key, start, end)
+}
+
+// PluralizeRangeContext works just like PluralizeRange, but for a key in a
+// particular context, like PluralizeContext.
+func (t *Translator) PluralizeRangeContext(context string, key string, start, end float64) (translation string, errors []error) {
+	return t.pluralizeRange(context, key, start, end)
+}
+
+// pluralizeRange does the work for PluralizeRange and PluralizeRangeContext
+func (t *Translator) pluralizeRange(context string, key string, start, end float64) (translation string, errors []error) {
+	messageKey, ok := t.pluralKey(context, key)
+	if !ok {
+		if t.fallback != nil && t.fallback != t {
+			return t.fallback.pluralizeRange(context, key, start, end)
+		}
+
+		errors = append(errors, t.keyNotFound(context, key))
+		return
+	}
+
+	category := t.rules.pluralRange((t.rules.PluralRuleFunc)(start), (t.rules.PluralRuleFunc)(end))
+
+	message, ok, errors := t.pluralMessage(messageKey, category, t.rules.PluralCategories)
+	if !ok {
+		return
+	}
+
+	var errs []error
+	translation, errs = t.substitute(message, map[string]string{
+		"start": t.FormatNumber(start),
+		"end":   t.FormatNumber(end),
+	})
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// pluralKey returns the key this translator has a plural message for a key in
+// a context stored with - the key in the context, or the key without a
+// context if there's no message in the context.
+func (t *Translator) pluralKey(context string, key string) (string, bool) {
+	for _, k := range []string{contextKey(context, key), key} {
+		_, isMessage := t.messages[k]
+		_, isVariants := t.variants[k]
+		if isMessage || isVariants {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
+// keyNotFound returns the error for a key, in a context if it's not empty,
+// that neither this translator nor its fallbacks have a message for
+func (t *Translator) keyNotFound(context string, key string) error {
+	if context == "" {
+		return translatorError{translator: t, message: "key not found: " + key}
+	}
+
+	return translatorError{translator: t, message: "key not found: " + key + " (context: " + context + ")"}
+}
+
+// pluralMessage returns the variant of a plural message for the requested
+// category. The message can either be a plural category map or a single string
+// with its variants separated by "|". ok is false if the message has nothing
+// to render for the category.
+func (t *Translator) pluralMessage(key string, category pluralCategory, categories []pluralCategory) (message string, ok bool, errors []error) {
+	if _, ok := t.variants[key]; ok {
+		return t.pluralVariant(key, category, categories)
+	}
+
+	form := 0
+	for i, c := range categories {
+		if c == category {
+			form = i
+		}
+	}
 
 	parts := strings.Split(t.messages[key], "|")
 
@@ -352,11 +746,33 @@ func (t *Translator) Pluralize(key string, number float64, numberStr string) (tr
 		form = len(parts) - 1
 	}
 
-	var errs []error
-	translation, errs = t.substitute(parts[form], map[string]string{"n": numberStr})
-	for _, err := range errs {
-		errors = append(errors, err)
+	message = parts[form]
+	return message, true, errors
+}
+
+// pluralVariant returns the variant of a plural category map message for the
+// requested category. It also validates that the message has a variant for
+// every one of the categories passed in. A message with neither a variant for
+// the category nor an "other" variant has nothing to render, which is an
+// error.
+func (t *Translator) pluralVariant(key string, category pluralCategory, categories []pluralCategory) (message string, ok bool, errors []error) {
+	variants := t.variants[key]
+
+	for _, c := range categories {
+		if _, ok := variants[c.String()]; !ok {
+			errors = append(errors, translatorError{translator: t, message: "missing plural category " + c.String() + ": " + key})
+		}
+	}
+
+	message, ok = variants[category.String()]
+	if !ok {
+		message, ok = variants[pluralCategoryOther.String()]
 	}
+
+	if !ok {
+		errors = append(errors, translatorError{translator: t, message: "missing plural variant " + category.String() + ": " + key})
+	}
+
 	return
 }
 
@@ -371,6 +787,11 @@ func (t *Translator) Rules() TranslatorRules {
 	return rules
 }
 
+// Locale returns the locale code the Translator was created for
+func (t *Translator) Locale() string {
+	return t.locale
+}
+
 // Direction returns the text directionality of the locale's writing system
 func (t *Translator) Direction() (direction string) {
 	return t.rules.Direction
@@ -392,69 +813,313 @@ func (t *Translator) substitute(str string, substitutions map[string]string) (su
 	return
 }
 
-// loadMessages loads all messages from the properly named locale message yaml
-// files in the requested messagesPaths.  if multiple paths are provided, paths
-// further down the list take precedence over earlier paths.
-func loadMessages(locale string, messagesPaths []string) (messages map[string]string, errors []error) {
+// loadMessages loads all messages for a locale from the requested message
+// sources. if multiple sources are provided, sources further down the list
+// take precedence over earlier sources. Messages with named variants, like
+// plural messages organized by plural category, are returned in the variants
+// map. The locale's rules are passed to the sources for formats that need
+// them, like gettext PO and MO files.
+func loadMessages(locale string, messageSources []MessageSource, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error) {
 
 	messages = make(map[string]string)
+	variants = make(map[string]map[string]string)
 
 	found := false
-	for _, p := range messagesPaths {
-		p = strings.TrimRight(p, pathSeparator)
-		file := p + pathSeparator + locale + ".yaml"
-
-		_, statErr := os.Stat(file)
-		if statErr == nil {
-			contents, readErr := ioutil.ReadFile(file)
-			if readErr != nil {
-				errors = append(errors, translatorError{message: "can't open messages file: " + readErr.Error()})
-			} else {
-				newmap := map[string]string{}
-				yamlErr := yaml.Unmarshal(contents, &newmap)
-				if yamlErr != nil {
-					errors = append(errors, translatorError{message: "can't load messages YAML: " + yamlErr.Error()})
-				} else {
-					found = true
-					for key, value := range newmap {
-						messages[key] = value
-					}
-				}
+	for _, source := range messageSources {
+		sourceMessages, sourceVariants, errs := source.Messages(locale, rules)
+		for _, err := range errs {
+			errors = append(errors, err)
+		}
+
+		if sourceMessages != nil || sourceVariants != nil {
+			found = true
+		}
+
+		for key, value := range sourceMessages {
+			messages[key] = value
+			delete(variants, key)
+		}
+
+		for key, value := range sourceVariants {
+			variants[key] = value
+			delete(messages, key)
+		}
+	}
+
+	if !found {
+		errors = append(errors, translatorError{message: "no messages files found: " + locale, warning: true})
+	}
+
+	return
+}
+
+// messagesFiles returns the messages files for a locale in a single messages
+// file system, in the order they should be loaded. First the files named after
+// the locale, and then the files in a directory named after the locale. Files
+// with the same name are loaded in the order of the messagesExtensions. Files
+// and directories are matched by their canonical locale codes in the file
+// system's index, so "en_GB.po" is found for "en-GB".
+func messagesFiles(fsys fs.FS, index localeIndex, locale string) (files []string, errors []error) {
+	names := index.entries(locale, messagesExtensions)
+
+	for _, ext := range messagesExtensions {
+		for _, file := range names {
+			if !strings.HasSuffix(file, ext) {
+				continue
 			}
+
+			info, err := fs.Stat(fsys, file)
+			if err == nil && !info.IsDir() {
+				files = append(files, file)
+			} else if err != nil && !os.IsNotExist(err) {
+				errors = append(errors, translatorError{message: "error getting file info: " + err.Error()})
+			}
+		}
+	}
+
+	// now look for directories named after this locale and get their children
+	for _, dir := range names {
+		info, statErr := fs.Stat(fsys, dir)
+		if statErr != nil || !info.IsDir() {
+			continue
 		}
 
-		// now look for a directory named after this locale and get an *.yaml children
-		dir := p + pathSeparator + locale
-		info, statErr := os.Stat(dir)
-		if statErr == nil && info.IsDir() {
-			// found the directory - now look for *.yaml files
-			files, globErr := filepath.Glob(dir + pathSeparator + "*.yaml")
+		for _, ext := range messagesExtensions {
+			matches, globErr := fs.Glob(fsys, dir+"/*"+ext)
 			if globErr != nil {
 				errors = append(errors, translatorError{message: "can't glob messages files: " + globErr.Error()})
 			}
-			for _, file := range files {
-				contents, readErr := ioutil.ReadFile(file)
-				if readErr != nil {
-					errors = append(errors, translatorError{message: "can't open messages file: " + readErr.Error()})
-				} else {
-					newmap := map[string]string{}
-					yamlErr := yaml.Unmarshal(contents, &newmap)
-					if yamlErr != nil {
-						errors = append(errors, translatorError{message: "can't load messages YAML: " + yamlErr.Error()})
-					} else {
-						found = true
-						for key, value := range newmap {
-							messages[key] = value
-						}
-					}
+			for _, file := range matches {
+				_, err := fs.Stat(fsys, file)
+				if err == nil {
+					files = append(files, file)
+				} else if !os.IsNotExist(err) {
+					errors = append(errors, translatorError{message: "error getting file info: " + err.Error()})
 				}
 			}
 		}
 	}
 
-	if !found {
-		errors = append(errors, translatorError{message: "no messages files found: " + locale})
+	return
+}
+
+// rulesFiles returns the rules files for a locale in a single rules file
+// system, in the order they should be loaded. Files are matched by their
+// canonical locale codes, like messages files. Files that don't exist are
+// skipped when the rules are loaded.
+func rulesFiles(fsys fs.FS, index localeIndex, locale string) (files []catalogFile) {
+	names := index.entries(locale, rulesExtensions)
+	for _, ext := range rulesExtensions {
+		for _, name := range names {
+			if strings.HasSuffix(name, ext) {
+				files = append(files, catalogFile{fsys: fsys, name: name})
+			}
+		}
+	}
+	return
+}
+
+// loadMessagesFile reads a single messages file, adding its messages to the
+// messages and variants maps. The file format is chosen by the file's
+// extension. String values are plain messages, and map values are messages
+// with named variants. Maps can be nested as deeply as you like, and the
+// messages in them are stored with dotted keys - "title" in the "checkout" map
+// is stored as "checkout.title".
+func loadMessagesFile(fsys fs.FS, file string, rules *TranslatorRules, messages map[string]string, variants map[string]map[string]string) error {
+	contents, readErr := fs.ReadFile(fsys, file)
+	if readErr != nil {
+		return translatorError{message: "can't open messages file: " + readErr.Error()}
+	}
+
+	return parseMessages(path.Ext(file), contents, rules, messages, variants)
+}
+
+// parseMessages parses the contents of a messages file in the format for its
+// extension into the messages and variants maps. Files with an unknown
+// extension are parsed as yaml.
+func parseMessages(ext string, contents []byte, rules *TranslatorRules, messages map[string]string, variants map[string]map[string]string) error {
+	newmap := map[string]string{}
+	newVariants := map[string]map[string]string{}
+
+	var err error
+	switch ext {
+	case ".json":
+		err = parseMessagesJSON(contents, newmap, newVariants)
+	case ".po":
+		err = parseMessagesPO(contents, rules, newmap, newVariants)
+	case ".mo":
+		err = parseMessagesMO(contents, rules, newmap, newVariants)
+	default:
+		err = parseMessagesYAML(contents, newmap, newVariants)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for key, value := range newmap {
+		messages[key] = value
+		delete(variants, key)
+	}
+
+	for key, value := range newVariants {
+		variants[key] = value
+		delete(messages, key)
+	}
+
+	return nil
+}
+
+// parseMessagesYAML parses the contents of a messages yaml file into the
+// messages and variants maps
+func parseMessagesYAML(contents []byte, messages map[string]string, variants map[string]map[string]string) error {
+	tree := map[string]interface{}{}
+	yamlErr := yaml.Unmarshal(contents, &tree)
+	if yamlErr != nil {
+		return translatorError{message: "can't load messages YAML: " + yamlErr.Error()}
+	}
+
+	// the yaml package resolves values like 1.0 or yes into numbers and
+	// booleans when unmarshalling into an interface{}, but keeps their text
+	// when unmarshalling into a string - so messages and variants that are
+	// only one level deep are unmarshalled again to keep their text as is
+	raw := map[string]string{}
+	yamlErr = yaml.Unmarshal(contents, &raw)
+	if yamlErr != nil {
+		return translatorError{message: "can't load messages YAML: " + yamlErr.Error()}
+	}
+
+	rawVariants := map[string]map[string]string{}
+	yamlErr = yaml.Unmarshal(contents, &rawVariants)
+	if yamlErr != nil {
+		return translatorError{message: "can't load messages YAML: " + yamlErr.Error()}
+	}
+
+	addMessageTree(tree, messages, variants)
+
+	for key, value := range raw {
+		messages[key] = value
+	}
+
+	for key, value := range rawVariants {
+		if _, ok := variants[key]; ok {
+			variants[key] = value
+			for name, variant := range value {
+				messages[key+"."+name] = variant
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseMessagesJSON parses the contents of a messages json file into the
+// messages and variants maps
+func parseMessagesJSON(contents []byte, messages map[string]string, variants map[string]map[string]string) error {
+	tree := map[string]interface{}{}
+
+	// numbers are kept as they're written, rather than as float64s
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.UseNumber()
+
+	jsonErr := decoder.Decode(&tree)
+	if jsonErr != nil {
+		return translatorError{message: "can't load messages JSON: " + jsonErr.Error()}
+	}
+
+	addMessageTree(tree, messages, variants)
+
+	return nil
+}
+
+// addMessageTree adds the messages in an unmarshalled messages file to the
+// messages and variants maps. Messages grouped by context are stored with the
+// context in their key.
+func addMessageTree(tree map[string]interface{}, messages map[string]string, variants map[string]map[string]string) {
+	for key, value := range tree {
+		if key == messageContexts {
+			continue
+		}
+		flattenMessages(key, value, messages, variants)
+	}
+
+	contexts, _ := messageTree(tree[messageContexts])
+	for context, contextTree := range contexts {
+		contextMessages := map[string]string{}
+		contextVariants := map[string]map[string]string{}
+		flattenMessages("", contextTree, contextMessages, contextVariants)
+
+		for key, value := range contextMessages {
+			messages[contextKey(context, key)] = value
+		}
+
+		for key, value := range contextVariants {
+			variants[contextKey(context, key)] = value
+		}
 	}
+}
+
+// flattenMessages adds the messages in an unmarshalled value to the messages
+// map, using dotted keys for messages in nested maps. Maps that only contain
+// messages, like the plural categories of a plural message, are also added to
+// the variants map. Empty maps and lists are ignored.
+func flattenMessages(key string, value interface{}, messages map[string]string, variants map[string]map[string]string) {
+	if tree, ok := messageTree(value); ok {
+		flat := true
+		children := make(map[string]string)
+
+		for childKey, child := range tree {
+			name := childKey
+			if key != "" {
+				name = key + "." + name
+			}
+
+			if _, isTree := messageTree(child); isTree {
+				flat = false
+			} else if _, isList := child.([]interface{}); isList {
+				flat = false
+			} else if child != nil {
+				children[childKey] = fmt.Sprint(child)
+			}
+
+			flattenMessages(name, child, messages, variants)
+		}
+
+		if flat && len(children) > 0 && key != "" {
+			variants[key] = children
+		}
+		return
+	}
+
+	switch value.(type) {
+	case []interface{}, nil:
+	default:
+		if key != "" {
+			messages[key] = fmt.Sprint(value)
+		}
+	}
+}
 
+// messageTree returns an unmarshalled map with string keys. The yaml package
+// unmarshals maps with interface{} keys, and the json package with string keys.
+func messageTree(value interface{}) (tree map[string]interface{}, ok bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return value, true
+	case map[interface{}]interface{}:
+		tree = make(map[string]interface{}, len(value))
+		for k, v := range value {
+			tree[fmt.Sprint(k)] = v
+		}
+		return tree, true
+	}
 	return
 }
+
+// contextKey returns the key a message is stored with for a context
+func contextKey(context string, key string) string {
+	if context == "" {
+		return key
+	}
+	return context + messageContextSeparator + key
+}
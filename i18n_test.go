@@ -1,9 +1,12 @@
 package i18n
 
 import (
+	"io/fs"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
+	"testing/fstest"
 
 	. "gopkg.in/check.v1"
 )
@@ -186,6 +189,68 @@ func (s *MySuite) TestNewTranslatorFactory(c *C) {
 	c.Check(errors, HasLen, 2)
 }
 
+func (s *MySuite) TestNewTranslatorFactoryFS(c *C) {
+	rulesFS := fstest.MapFS{
+		"en.yaml": {Data: []byte("plural: 2A\ndirection: LTR\n")},
+	}
+
+	messagesFS := fstest.MapFS{
+		"en.yaml":          {Data: []byte(`WELCOME: "Hello from the FS"`)},
+		"en/email.json":    {Data: []byte(`{"SUBJECT": "Hi"}`)},
+		"fr-CA/front.yaml": {Data: []byte(`WELCOME: "Bonjour du FS"`)},
+	}
+
+	// file systems are layered in order
+	f, errors := NewTranslatorFactoryFS(
+		[]fs.FS{os.DirFS("data/rules"), rulesFS},
+		[]fs.FS{os.DirFS("data/messages"), messagesFS},
+		"en",
+	)
+	c.Check(errors, HasLen, 0)
+	c.Assert(f, NotNil)
+	c.Check(f.rulesPaths, HasLen, 0)
+	c.Check(f.messageSources, HasLen, 2)
+	c.Check(f.fallback, NotNil)
+
+	tEn, errors := f.GetTranslator("en")
+	c.Check(errors, HasLen, 0)
+	c.Check(tEn.rules.Direction, Equals, "LTR")
+
+	m, _ := tEn.Translate("WELCOME", map[string]string{})
+	c.Check(m, Equals, "Hello from the FS")
+
+	m, _ = tEn.Translate("SUBJECT", map[string]string{})
+	c.Check(m, Equals, "Hi")
+
+	// messages from data/messages are still there
+	m, _ = tEn.Translate("WELCOME_USER", map[string]string{"user": "Ann"})
+	c.Check(m, Equals, "Welcome, Ann!")
+
+	tFr, errors := f.GetTranslator("fr-CA")
+	c.Check(errors, HasLen, 0)
+	m, _ = tFr.Translate("WELCOME", map[string]string{})
+	c.Check(m, Equals, "Bonjour du FS")
+
+	// only file systems
+	f, errors = NewTranslatorFactoryFS([]fs.FS{rulesFS}, []fs.FS{messagesFS}, "en")
+	c.Check(errors, HasLen, 0)
+	c.Assert(f, NotNil)
+
+	exists, _ := f.LocaleExists("fr-CA")
+	c.Check(exists, Equals, true)
+
+	exists, _ = f.LocaleExists("de")
+	c.Check(exists, Equals, false)
+
+	// test with no file systems
+	_, errors = NewTranslatorFactoryFS([]fs.FS{}, []fs.FS{}, "")
+	c.Check(errors, HasLen, 2)
+
+	// test with a file system that doesn't have the fallback locale
+	_, errors = NewTranslatorFactoryFS([]fs.FS{fstest.MapFS{}}, []fs.FS{messagesFS}, "en")
+	c.Check(len(errors) > 0, Equals, true)
+}
+
 func (s *MySuite) TestGetTranslator(c *C) {
 
 	f, errors := NewTranslatorFactory(
@@ -222,9 +287,16 @@ func (s *MySuite) TestGetTranslator(c *C) {
 	c.Assert(tFrCa.fallback, NotNil)
 	c.Check(tFrCa.fallback, Equals, tFr)
 
+	// locales with only rules only get warnings
+	tEnGb, errors := f.GetTranslator("en-GB")
+	c.Assert(tEnGb, NotNil)
+	c.Assert(errors, HasLen, 1)
+	c.Check(IsWarning(errors[0]), Equals, true)
+
 	_, errors = f.GetTranslator("does-not-exist")
 
 	c.Check(errors, Not(HasLen), 0)
+	c.Check(IsWarning(errors[0]), Equals, false)
 
 	f, _ = NewTranslatorFactory(
 		[]string{"data/rules"},
@@ -285,6 +357,65 @@ func (s *MySuite) TestGetFallback(c *C) {
 	c.Check(f.getFallback("fr-ca").locale, Equals, "fr")
 }
 
+func (s *MySuite) TestGetTranslatorConcurrent(c *C) {
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	locales := []string{"en", "fr", "fr-CA", "en-GB", "en-AU"}
+	results := make([][]*Translator, 8)
+
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for _, locale := range locales {
+				t, _ := f.GetTranslator(locale)
+				results[i] = append(results[i], t)
+
+				t.Translate("WELCOME_USER", map[string]string{"user": "Ann"})
+				t.Pluralize("TIME_UNIT_DAY", 2, "2")
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// every goroutine got the same translators
+	for i, locale := range locales {
+		c.Check(results[0][i].locale, Equals, locale)
+		for _, result := range results {
+			c.Check(result[i], Equals, results[0][i])
+		}
+	}
+
+	// translating while the factory is reloaded
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if i == 0 {
+				f.Reload()
+				return
+			}
+
+			for _, locale := range locales {
+				t, _ := f.GetTranslator(locale)
+				translation, _ := t.Translate("WELCOME_USER", map[string]string{"user": "Ann"})
+				c.Check(translation, Equals, "Welcome, Ann!")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
 func (s *MySuite) TestLocaleExists(c *C) {
 	f, _ := NewTranslatorFactory(
 		[]string{"data/rules"},
@@ -389,6 +520,114 @@ func (s *MySuite) TestTranslate(c *C) {
 
 	c.Check(errors, HasLen, 0)
 	c.Check(welcome, Equals, "Welcome!")
+
+	// test nested messages
+	pay, errors := tEn.Translate("checkout.payment.button", map[string]string{"amount": "$5"})
+
+	c.Check(errors, HasLen, 0)
+	c.Check(pay, Equals, "Pay $5")
+
+	items, errors := tEn.Pluralize("checkout.items", 2, "2")
+
+	c.Check(errors, HasLen, 0)
+	c.Check(items, Equals, "2 items")
+}
+
+func (s *MySuite) TestTranslateContext(c *C) {
+
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+
+	c.Assert(tEn, NotNil)
+
+	m, errors := tEn.TranslateContext("store", "OPEN", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Open now")
+
+	// the message without a context is used if there's no contextual message
+	m, errors = tEn.TranslateContext("menu", "OPEN", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Open")
+
+	m, errors = tEn.TranslateContext("", "WELCOME_USER", map[string]string{"user": "Ann"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Welcome, Ann!")
+
+	// a translation without a context is used before the fallback's
+	// contextual translation
+	tFr, _ := f.GetTranslator("fr")
+	m, errors = tFr.TranslateContext("store", "OPEN", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Ouvrir")
+
+	m, errors = tFr.TranslateContext("store", "CLOSED", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Fermé")
+
+	m, errors = tFr.TranslateContext("store", "WELCOME", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Welcome!")
+
+	// contextual messages can't be translated without their context
+	_, errors = tFr.Translate("CLOSED", map[string]string{})
+	c.Check(errors, HasLen, 1)
+
+	_, errors = tEn.TranslateContext("store", "THIS_KEY_DOES_NOT_EXIST", map[string]string{})
+	c.Check(errors, HasLen, 1)
+}
+
+func (s *MySuite) TestSelect(c *C) {
+
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+
+	c.Assert(tEn, NotNil)
+
+	m, errors := tEn.Select("FRIEND_REQUEST", "female", map[string]string{"name": "Ann"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Ann wants to add you to her friends")
+
+	m, errors = tEn.Select("FRIEND_REQUEST", "male", map[string]string{"name": "Bob"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Bob wants to add you to his friends")
+
+	// the other variant is the default
+	m, errors = tEn.Select("FRIEND_REQUEST", "unknown", map[string]string{"name": "Sam"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Sam wants to add you to their friends")
+
+	_, errors = tEn.Select("FRIEND_REQUEST_GENDERED", "unknown", map[string]string{"name": "Sam"})
+	c.Check(errors, HasLen, 1)
+
+	// messages without variants are used for every selector
+	m, errors = tEn.Select("WELCOME_USER", "female", map[string]string{"user": "Ann"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Welcome, Ann!")
+
+	// variants are found through the fallback
+	tFr, _ := f.GetTranslator("fr")
+	m, errors = tFr.Select("FRIEND_REQUEST", "male", map[string]string{"name": "Luc"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Luc wants to add you to his friends")
+
+	_, errors = tEn.Select("THIS_KEY_DOES_NOT_EXIST", "male", map[string]string{})
+	c.Check(errors, HasLen, 1)
 }
 
 func (s *MySuite) TestPluralize(c *C) {
@@ -434,6 +673,196 @@ func (s *MySuite) TestPluralize(c *C) {
 	c.Check(errors, HasLen, 2)
 	c.Check(p, Equals, "Welcome!")
 
+	// named plural categories
+	p, errors = tEn.Pluralize("ITEM_COUNT", 1, "1")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1 item")
+
+	p, errors = tEn.Pluralize("ITEM_COUNT", 5, "5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "5 items")
+
+	// missing categories are reported
+	p, errors = tEn.Pluralize("ITEM_COUNT_INCOMPLETE", 1, "1")
+	c.Check(errors, HasLen, 1)
+	c.Check(p, Equals, "1 item")
+
+	p, errors = tEn.Pluralize("ITEM_COUNT_INCOMPLETE", 5, "5")
+	c.Check(errors, HasLen, 2)
+	c.Check(p, Equals, "")
+
+	// a category map without the category or an other variant is an error,
+	// even when the category isn't one the message is checked for
+	_, ok, errors := tEn.pluralVariant("ITEM_COUNT_INCOMPLETE", pluralCategoryFew, []pluralCategory{pluralCategoryOne})
+	c.Check(ok, Equals, false)
+	c.Check(errors, HasLen, 1)
+
+	p, errors = tEn.PluralizeRange("ITEM_COUNT_INCOMPLETE", 1, 5)
+	c.Check(errors, HasLen, 2)
+	c.Check(p, Equals, "")
+
+	// plural categories are found through the fallback
+	tFr, _ := f.GetTranslator("fr")
+	p, errors = tFr.Pluralize("ITEM_COUNT", 1, "1")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1 item")
+
+	// visible fraction digits in the number string are used
+	p, errors = tEn.Pluralize("ITEM_COUNT", 1, "1.0")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1.0 items")
+
+	price, _ := tEn.FormatCurrency(1, "USD")
+	p, errors = tEn.Pluralize("ITEM_COUNT", 1, price)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "$1.00 items")
+
+	// unless the number string is a different number, or not a number
+	p, errors = tEn.Pluralize("ITEM_COUNT", 1, "1.5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1.5 item")
+
+	p, errors = tEn.Pluralize("ITEM_COUNT", 1, "one")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "one item")
+}
+
+func (s *MySuite) TestPluralizeContext(c *C) {
+
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+
+	c.Assert(tEn, NotNil)
+
+	p, errors := tEn.PluralizeContext("cart", "ITEM_COUNT", 1, "1")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1 item in your cart")
+
+	p, errors = tEn.PluralizeContext("cart", "ITEM_COUNT", 5, "5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "5 items in your cart")
+
+	// the message without a context is used if there's no contextual message
+	p, errors = tEn.PluralizeContext("menu", "ITEM_COUNT", 5, "5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "5 items")
+
+	p, errors = tEn.PluralizeOrdinalContext("race", "PLACE", 2, "2")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "2nd across the line")
+
+	p, errors = tEn.PluralizeOrdinalContext("menu", "PLACE", 2, "2")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "2nd place")
+
+	p, errors = tEn.PluralizeRangeContext("shipping", "DAY_RANGE", 1, 3)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "Delivery in 1–3 days")
+
+	p, errors = tEn.PluralizeRangeContext("menu", "DAY_RANGE", 1, 3)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1–3 days")
+
+	// contextual messages are found through the fallback
+	tFr, _ := f.GetTranslator("fr")
+	p, errors = tFr.PluralizeContext("cart", "ITEM_COUNT", 5, "5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "5 items in your cart")
+
+	_, errors = tEn.PluralizeContext("cart", "THIS_KEY_DOES_NOT_EXIST", 5, "5")
+	c.Check(errors, HasLen, 1)
+}
+
+func (s *MySuite) TestPluralizeOrdinal(c *C) {
+
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+
+	c.Assert(tEn, NotNil)
+
+	// named plural categories
+	for n, expected := range map[float64]string{1: "1st place", 2: "2nd place", 3: "3rd place", 4: "4th place", 11: "11th place", 12: "12th place", 21: "21st place", 103: "103rd place"} {
+		p, errors := tEn.PluralizeOrdinal("PLACE", n, tEn.FormatNumber(n))
+		c.Check(errors, HasLen, 0)
+		c.Check(p, Equals, expected)
+	}
+
+	// "|" separated variants
+	p, errors := tEn.PluralizeOrdinal("TURN", 2, "2")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "Take the 2nd left")
+
+	p, errors = tEn.PluralizeOrdinal("TURN", 5, "5")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "Take the 5th left")
+
+	// fallback translators, with the fallback's rules
+	tFr, _ := f.GetTranslator("fr")
+	p, errors = tFr.PluralizeOrdinal("PLACE", 2, "2")
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "2nd place")
+
+	_, errors = tEn.PluralizeOrdinal("THIS_KEY_DOES_NOT_EXIST", 2, "2")
+	c.Check(errors, HasLen, 1)
+}
+
+func (s *MySuite) TestPluralizeRange(c *C) {
+
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+
+	c.Assert(tEn, NotNil)
+
+	// named plural categories
+	p, errors := tEn.PluralizeRange("DAY_RANGE", 1, 3)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1–3 days")
+
+	p, errors = tEn.PluralizeRange("DAY_RANGE", 0, 1)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "0–1 days")
+
+	p, errors = tEn.PluralizeRange("DAY_RANGE", 1000, 1500.5)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "1,000–1,500.5 days")
+
+	// "|" separated variants
+	p, errors = tEn.PluralizeRange("WEEK_RANGE", 2, 4)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "2–4 weeks")
+
+	// fallback translators, with the fallback's rules
+	tFr, _ := f.GetTranslator("fr")
+	p, errors = tFr.PluralizeRange("DAY_RANGE", 0, 1)
+	c.Check(errors, HasLen, 0)
+	c.Check(p, Equals, "0–1 days")
+
+	_, errors = tEn.PluralizeRange("THIS_KEY_DOES_NOT_EXIST", 1, 2)
+	c.Check(errors, HasLen, 1)
 }
 
 func (s *MySuite) TestDirection(c *C) {
@@ -480,13 +909,108 @@ func (s *MySuite) TestSubstitute(c *C) {
 }
 
 func (s *MySuite) TestLoadMessages(c *C) {
-	messages, errors := loadMessages("en", []string{"data/messages", s.messagesDir})
+	messages, variants, errors := loadMessages("en", []MessageSource{NewDirSource("data/messages"), NewDirSource(s.messagesDir)}, nil)
 	c.Check(errors, HasLen, 0)
 	c.Check(messages["TIME_UNIT_DAY"], Equals, "{n} day|{n} days")
 	c.Check(messages["WELCOME"], Equals, "Howdy!")
 	c.Check(messages["GOODBYE"], Equals, "So long!")
 
-	messages, errors = loadMessages("xx", []string{"does/not/exist"})
+	c.Check(variants["ITEM_COUNT"], DeepEquals, map[string]string{"one": "{n} item", "other": "{n} items"})
+
+	// nested messages use dotted keys
+	c.Check(messages["checkout.title"], Equals, "Checkout")
+	c.Check(messages["checkout.payment.button"], Equals, "Pay {amount}")
+	c.Check(messages["checkout.payment.version"], Equals, "1")
+	c.Check(messages["checkout.items.one"], Equals, "{n} item")
+	c.Check(variants["checkout.items"], DeepEquals, map[string]string{"one": "{n} item", "other": "{n} items"})
+	c.Check(variants["checkout.payment"], DeepEquals, map[string]string{"button": "Pay {amount}", "version": "1"})
+	_, ok := variants["checkout"]
+	c.Check(ok, Equals, false)
+
+	// values only one level deep are kept as is
+	c.Check(messages["APP_VERSION"], Equals, "1.0")
+	c.Check(variants["ITEM_COUNT"], HasLen, 2)
+	c.Check(messages["ITEM_COUNT.other"], Equals, "{n} items")
+
+	// messages grouped by context
+	c.Check(messages["store\x04OPEN"], Equals, "Open now")
+	c.Check(variants["cart\x04ITEM_COUNT"], HasLen, 2)
+	c.Check(messages["store\x04hours.weekend"], Equals, "Open on weekends")
+	_, ok = messages["_context"]
+	c.Check(ok, Equals, false)
+	_, ok = variants["_context"]
+	c.Check(ok, Equals, false)
+
+	messages, variants, errors = loadMessages("xx", []MessageSource{NewDirSource("does/not/exist")}, nil)
 	c.Check(errors, Not(HasLen), 0)
 	c.Check(messages, HasLen, 0)
+	c.Check(variants, HasLen, 0)
+
+	// a locale without messages files of its own only gets a warning
+	_, _, errors = loadMessages("de", []MessageSource{NewDirSource("data/messages")}, nil)
+	c.Assert(errors, HasLen, 1)
+	c.Check(IsWarning(errors[0]), Equals, true)
+
+	dir := c.MkDir()
+	err := ioutil.WriteFile(dir+"/xx.yaml", []byte("KEY: [unterminated"), os.FileMode(0777))
+	c.Assert(err, IsNil)
+	_, _, errors = loadMessages("xx", []MessageSource{NewDirSource(dir)}, nil)
+	c.Assert(errors, Not(HasLen), 0)
+	c.Check(IsWarning(errors[0]), Equals, false)
+}
+
+func (s *MySuite) TestLoadMessagesJSON(c *C) {
+	dir := c.MkDir()
+
+	err := os.Mkdir(dir+"/en", os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	err = ioutil.WriteFile(dir+"/en.json", []byte(`{
+		"WELCOME": "Welcome from JSON!",
+		"VERSION": 1.0,
+		"ITEM_COUNT": {"one": "{n} item", "other": "{n} items"},
+		"checkout": {"title": "Checkout"},
+		"_context": {"store": {"OPEN": "Open now"}}
+	}`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	err = ioutil.WriteFile(dir+"/en/email.json", []byte(`{"SUBJECT": "Hello"}`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	// json files are loaded after yaml files with the same name
+	err = ioutil.WriteFile(dir+"/en.yaml", []byte(`WELCOME: "Welcome from YAML!"`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	messages, variants, errors := loadMessages("en", []MessageSource{NewDirSource(dir)}, nil)
+	c.Check(errors, HasLen, 0)
+	c.Check(messages["WELCOME"], Equals, "Welcome from JSON!")
+	c.Check(messages["VERSION"], Equals, "1.0")
+	c.Check(messages["SUBJECT"], Equals, "Hello")
+	c.Check(messages["checkout.title"], Equals, "Checkout")
+	c.Check(messages["store\x04OPEN"], Equals, "Open now")
+	c.Check(variants["ITEM_COUNT"], DeepEquals, map[string]string{"one": "{n} item", "other": "{n} items"})
+
+	err = ioutil.WriteFile(dir+"/fr.json", []byte(`{"WELCOME": `), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	_, _, errors = loadMessages("fr", []MessageSource{NewDirSource(dir)}, nil)
+	c.Check(errors, HasLen, 2)
+
+	// a factory with only json rules and messages
+	rulesDir := c.MkDir()
+	err = ioutil.WriteFile(rulesDir+"/en.json", []byte(`{"plural": "2A", "direction": "LTR"}`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	f, errors := NewTranslatorFactory([]string{rulesDir}, []string{dir}, "en")
+	c.Check(errors, HasLen, 0)
+
+	tEn, errors := f.GetTranslator("en")
+	c.Check(errors, HasLen, 0)
+
+	exists, _ := f.LocaleExists("en")
+	c.Check(exists, Equals, true)
+
+	m, errors := tEn.Pluralize("ITEM_COUNT", 1, "1")
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1 item")
 }
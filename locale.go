@@ -0,0 +1,174 @@
+package i18n
+
+import (
+	"io/fs"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// CanonicalLocale returns the canonical BCP 47 form of a locale code. Subtags
+// get their canonical case, "_" separators are replaced with "-", deprecated
+// codes are replaced - "iw" becomes "he", and "sh" becomes "sr-Latn" - and a
+// script is added when the region uses a different script than the language
+// usually does, so "zh-TW" becomes "zh-Hant-TW". Codes which aren't valid
+// language tags are only given "-" separators. The factory canonicalizes
+// locale codes before it looks for their files, so "en_gb", "en-GB" and
+// "EN-gb" all get the same Translator.
+func CanonicalLocale(locale string) string {
+	if locale == "" || locale == "root" {
+		return locale
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return strings.Replace(locale, "_", "-", -1)
+	}
+
+	// add the script implied by the region, if it's not the language's usual
+	// script
+	_, scriptConfidence := tag.Script()
+	_, regionConfidence := tag.Region()
+	if scriptConfidence != language.Exact && regionConfidence == language.Exact {
+		base, _ := tag.Base()
+		script, _ := tag.Script()
+		baseScript, _ := language.Make(base.String()).Script()
+		if script != baseScript {
+			if withScript, err := language.Compose(tag, script); err == nil {
+				tag = withScript
+			}
+		}
+	}
+
+	return tag.String()
+}
+
+// localeParents returns the less specific locales a canonical locale falls
+// back to, most specific first, without the locale itself or "root".
+// Extensions, variants and private use subtags are removed first, so
+// "en-US-u-va-posix" falls back to "en-US" and then "en". Languages written in
+// more than one script fall back through their likely script too, so "zh-CN"
+// falls back to "zh-Hans-CN", "zh-Hans" and then "zh". Codes which aren't valid
+// language tags have their subtags removed one at a time.
+func localeParents(locale string) (parents []string) {
+	tag, err := language.Parse(locale)
+	base, baseConfidence := tag.Base()
+	if err != nil || baseConfidence == language.No {
+		for parts := parentParts(strings.Split(locale, "-")); len(parts) > 0; parts = parentParts(parts) {
+			parents = append(parents, strings.Join(parts, "-"))
+		}
+		return
+	}
+
+	script, scriptConfidence := tag.Script()
+	region, regionConfidence := tag.Region()
+
+	// a script that isn't explicit is only added to locales with a region,
+	// when it's uncertain, which means the language is written in more than
+	// one - "zh-Hans" is a parent of "zh-CN", but not of "zh"
+	scriptPart := ""
+	if scriptConfidence == language.Exact || (scriptConfidence == language.Low && regionConfidence == language.Exact) {
+		scriptPart = "-" + script.String()
+	}
+
+	candidates := []string{}
+	if regionConfidence == language.Exact {
+		candidates = append(candidates, base.String()+scriptPart+"-"+region.String())
+		if scriptConfidence != language.Exact {
+			candidates = append(candidates, base.String()+"-"+region.String())
+		}
+	}
+	if scriptPart != "" {
+		candidates = append(candidates, base.String()+scriptPart)
+	}
+	candidates = append(candidates, base.String())
+
+	seen := map[string]bool{locale: true}
+	for _, candidate := range candidates {
+		if !seen[candidate] {
+			seen[candidate] = true
+			parents = append(parents, candidate)
+		}
+	}
+
+	return
+}
+
+// parentParts removes the last subtag from a language tag split into its
+// subtags. Like RFC 4647 lookup, a single character subtag left at the end,
+// like the "x" of a private use subtag, is removed along with it.
+func parentParts(parts []string) []string {
+	parts = parts[:len(parts)-1]
+	if len(parts) > 0 && len(parts[len(parts)-1]) == 1 {
+		parts = parts[:len(parts)-1]
+	}
+	return parts
+}
+
+// localeIndex maps canonical locale codes to the names of the files and
+// directories in the root of a file system which are named after them, with or
+// without one of the messages extensions (which include the rules extensions).
+// Names are indexed by their canonical locale codes, so "en_GB.po" and
+// "en-gb/" are both found for "en-GB". A nil index is for a file system that
+// can't be listed.
+type localeIndex map[string][]string
+
+// newLocaleIndex lists the root of a file system and indexes its entries by
+// their canonical locale codes. It returns nil if the file system can't be
+// listed.
+func newLocaleIndex(fsys fs.FS) localeIndex {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil
+	}
+
+	index := localeIndex{}
+	for _, entry := range entries {
+		name := entry.Name()
+		code := name
+		for _, ext := range messagesExtensions {
+			if strings.HasSuffix(name, ext) {
+				code = strings.TrimSuffix(name, ext)
+				break
+			}
+		}
+
+		if code != "" {
+			code = CanonicalLocale(code)
+			index[code] = append(index[code], name)
+		}
+	}
+
+	return index
+}
+
+// entries returns the names of the files and directories named after a
+// locale. If the file system couldn't be listed, the usual spellings of the
+// locale's name - "en-GB", "en-gb", "en_GB" and "en_gb" - are returned, with
+// and without each of the extensions.
+func (index localeIndex) entries(locale string, extensions []string) (names []string) {
+	locale = CanonicalLocale(locale)
+
+	if index == nil {
+		seen := map[string]bool{}
+		for _, spelling := range []string{
+			locale,
+			strings.ToLower(locale),
+			strings.Replace(locale, "-", "_", -1),
+			strings.ToLower(strings.Replace(locale, "-", "_", -1)),
+		} {
+			if seen[spelling] {
+				continue
+			}
+			seen[spelling] = true
+
+			names = append(names, spelling)
+			for _, ext := range extensions {
+				names = append(names, spelling+ext)
+			}
+		}
+		return
+	}
+
+	return index[locale]
+}
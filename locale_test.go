@@ -0,0 +1,154 @@
+package i18n
+
+import (
+	"testing/fstest"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestCanonicalLocale(c *C) {
+	tests := map[string]string{
+		"":              "",
+		"root":          "root",
+		"en":            "en",
+		"EN":            "en",
+		"en_gb":         "en-GB",
+		"en-gb":         "en-GB",
+		"pt_BR":         "pt-BR",
+		"zh-hant":       "zh-Hant",
+		"zh_TW":         "zh-Hant-TW",
+		"zh-CN":         "zh-CN",
+		"zh-Hans-CN":    "zh-Hans-CN",
+		"sr-ME":         "sr-Latn-ME",
+		"iw":            "he",
+		"iw-IL":         "he-IL",
+		"in":            "id",
+		"sh":            "sr-Latn",
+		"de-CH-1996":    "de-CH-1996",
+		"fr-x-private":  "fr-x-private",
+		"en-toolongtag": "en-toolongtag",
+		"en_toolongtag": "en-toolongtag",
+	}
+
+	for locale, expected := range tests {
+		c.Check(CanonicalLocale(locale), Equals, expected, Commentf(locale))
+	}
+}
+
+func (s *MySuite) TestLocaleFiles(c *C) {
+	fsys := fstest.MapFS{
+		"en-gb.yaml":         {Data: []byte("WELCOME: \"Hello\"")},
+		"en_GB.po":           {Data: []byte("")},
+		"en_gb/extra.json":   {Data: []byte(`{"EXTRA": "Extra"}`)},
+		"zh_TW.yaml":         {Data: []byte("WELCOME: \"你好\"")},
+		"iw.json":            {Data: []byte(`{"WELCOME": "שלום"}`)},
+		"en.yaml":            {Data: []byte("WELCOME: \"Hello\"")},
+		"README.md":          {Data: []byte("")},
+		"en-gb-extra/x.yaml": {Data: []byte("")},
+	}
+
+	index := newLocaleIndex(fsys)
+
+	files, errors := messagesFiles(fsys, index, "en-GB")
+	c.Check(errors, HasLen, 0)
+	c.Check(files, DeepEquals, []string{"en-gb.yaml", "en_GB.po", "en_gb/extra.json"})
+
+	files, _ = messagesFiles(fsys, index, "zh-Hant-TW")
+	c.Check(files, DeepEquals, []string{"zh_TW.yaml"})
+
+	files, _ = messagesFiles(fsys, index, "he")
+	c.Check(files, DeepEquals, []string{"iw.json"})
+
+	files, _ = messagesFiles(fsys, index, "fr")
+	c.Check(files, HasLen, 0)
+
+	rules := rulesFiles(fsys, index, "en-GB")
+	c.Assert(rules, HasLen, 1)
+	c.Check(rules[0].name, Equals, "en-gb.yaml")
+
+	// file systems that can't be listed are tried with the usual spellings
+	files, _ = messagesFiles(fsys, nil, "en")
+	c.Check(files, DeepEquals, []string{"en.yaml"})
+
+	files, _ = messagesFiles(fsys, nil, "en-GB")
+	c.Check(files, DeepEquals, []string{"en-gb.yaml", "en_GB.po", "en_gb/extra.json"})
+
+	files, _ = messagesFiles(fsys, nil, "zh-Hant-TW")
+	c.Check(files, HasLen, 0)
+
+	c.Check(localeIndex(nil).entries("zh-Hant", nil), DeepEquals, []string{"zh-Hant", "zh-hant", "zh_Hant", "zh_hant"})
+	c.Check(localeIndex(nil).entries("en", nil), DeepEquals, []string{"en"})
+}
+
+func (s *MySuite) TestLocaleParents(c *C) {
+	tests := map[string][]string{
+		"en":               nil,
+		"en-GB":            {"en"},
+		"en-US-u-va-posix": {"en-US", "en"},
+		"de-CH-1996":       {"de-CH", "de"},
+		"fr-x-private":     {"fr"},
+		"zh-CN":            {"zh-Hans-CN", "zh-Hans", "zh"},
+		"zh-SG":            {"zh-Hans-SG", "zh-Hans", "zh"},
+		"zh-Hant-TW":       {"zh-Hant", "zh"},
+		"zh-Hans":          {"zh"},
+		"zh":               nil,
+		"sr-RS":            {"sr-Cyrl-RS", "sr-Cyrl", "sr"},
+		"sr-Latn-ME":       {"sr-Latn", "sr"},
+		"x-klingon":        nil,
+		"en-toolongtag":    {"en"},
+	}
+
+	for locale, expected := range tests {
+		c.Check(localeParents(locale), DeepEquals, expected, Commentf(locale))
+	}
+}
+
+func (s *MySuite) TestGetTranslatorCanonical(c *C) {
+	m := NewMemorySource()
+	m.AddMessages("en", map[string]interface{}{"WELCOME": "Hello", "COLOR": "color"})
+	m.AddMessages("en_gb", map[string]interface{}{"COLOR": "colour"})
+	m.AddMessages("zh", map[string]interface{}{"WELCOME": "你好", "COLOR": "颜色"})
+	m.AddMessages("zh-hant", map[string]interface{}{"COLOR": "顏色"})
+
+	f, errors := NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "EN")
+	c.Assert(errors, HasLen, 0)
+
+	tGB, errors := f.GetTranslator("en-gb")
+	c.Check(errors, HasLen, 0)
+	c.Check(tGB.Locale(), Equals, "en-GB")
+
+	// rules files are found by their canonical names too
+	c.Check(tGB.rules.Numbers.Formats.Currency, Equals, "¤#,##0.00")
+
+	tGB2, _ := f.GetTranslator("en_GB")
+	c.Check(tGB2, Equals, tGB)
+
+	exists, _ := f.LocaleExists("EN-gb")
+	c.Check(exists, Equals, true)
+
+	// zh-TW is canonicalized to zh-Hant-TW, so zh-Hant and zh are its fallbacks
+	tTW, _ := f.GetTranslator("zh_TW")
+	c.Assert(tTW, NotNil)
+	c.Check(tTW.Locale(), Equals, "zh-Hant-TW")
+	c.Assert(tTW.fallback, NotNil)
+	c.Check(tTW.fallback.Locale(), Equals, "zh-Hant")
+	c.Assert(tTW.fallback.fallback, NotNil)
+	c.Check(tTW.fallback.fallback.Locale(), Equals, "zh")
+
+	translation, _ := tTW.Translate("COLOR", map[string]string{})
+	c.Check(translation, Equals, "顏色")
+	translation, _ = tTW.Translate("WELCOME", map[string]string{})
+	c.Check(translation, Equals, "你好")
+
+	// zh-CN gets the rules for zh-Hans, the script it's written in
+	tCN, _ := f.GetTranslator("zh-CN")
+	c.Assert(tCN, NotNil)
+	c.Check(tCN.fallback.Locale(), Equals, "zh")
+	c.Check(tCN.rules.Numbers.Formats.Currency, Equals, "¤#,##0.00;(¤#,##0.00)")
+
+	// extensions and variants aren't used to find fallbacks
+	tPOSIX, errors := f.GetTranslator("en-US-POSIX")
+	c.Check(tPOSIX.Locale(), Equals, "en-US-u-va-posix")
+	c.Assert(tPOSIX.fallback, NotNil)
+	c.Check(tPOSIX.fallback.Locale(), Equals, "en")
+}
@@ -0,0 +1,472 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// message part types - a parsed message is a sequence of these
+const (
+	messagePartLiteral = iota
+	messagePartArgument
+	messagePartNumberSign
+	messagePartNumber
+	messagePartPlural
+	messagePartSelectOrdinal
+	messagePartSelect
+)
+
+// messageFormatOther is the selector every plural, selectordinal and select
+// argument must contain. It is used when no other selector matches.
+const messageFormatOther = "other"
+
+// messagePart is a single component of a parsed ICU MessageFormat message.
+// Literal parts only use the text field. Argument parts use the name field,
+// and complex arguments (plural, selectordinal, select) keep their sub
+// messages in the options map, keyed by selector ("one", "=0", "male", etc.)
+type messagePart struct {
+	partType int
+	text     string
+	name     string
+	style    string
+	offset   float64
+	options  map[string][]*messagePart
+}
+
+// messageParser is a simple recursive descent parser for ICU MessageFormat
+// message strings
+type messageParser struct {
+	input []rune
+	pos   int
+}
+
+// parseMessageFormat parses an ICU MessageFormat message string into a
+// sequence of message parts. Apostrophes follow the ICU "double optional"
+// convention - a doubled apostrophe is a literal apostrophe, and a single
+// apostrophe only starts a quoted literal section when it is directly followed
+// by a syntax character ({, }, # or |). Any other apostrophe is left as is, so
+// messages like "l'heure" do not need escaping.
+func parseMessageFormat(message string) ([]*messagePart, error) {
+	p := &messageParser{input: []rune(message)}
+
+	parts, err := p.parseMessage(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.input) {
+		return nil, p.error("unexpected }")
+	}
+
+	return parts, nil
+}
+
+// parseMessage parses message text until the end of the input, or until the
+// closing brace of a sub message if nested is true. The inPlural flag controls
+// whether a # is treated as a number placeholder.
+func (p *messageParser) parseMessage(nested, inPlural bool) ([]*messagePart, error) {
+	parts := []*messagePart{}
+	literal := []rune{}
+
+	flush := func() {
+		if len(literal) > 0 {
+			parts = append(parts, &messagePart{partType: messagePartLiteral, text: string(literal)})
+			literal = []rune{}
+		}
+	}
+
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+
+		switch {
+		case r == '\'':
+			literal = append(literal, p.parseApostrophe()...)
+		case r == '{':
+			flush()
+			part, err := p.parseArgument()
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		case r == '}':
+			if !nested {
+				return nil, p.error("unexpected }")
+			}
+			flush()
+			return parts, nil
+		case r == '#' && inPlural:
+			flush()
+			parts = append(parts, &messagePart{partType: messagePartNumberSign})
+			p.pos++
+		default:
+			literal = append(literal, r)
+			p.pos++
+		}
+	}
+
+	if nested {
+		return nil, p.error("unclosed sub message")
+	}
+
+	flush()
+	return parts, nil
+}
+
+// parseApostrophe handles an apostrophe at the current position and returns
+// the literal text it represents.
+func (p *messageParser) parseApostrophe() []rune {
+	p.pos++
+
+	// a doubled apostrophe is always a single literal apostrophe
+	if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+		p.pos++
+		return []rune{'\''}
+	}
+
+	// a lone apostrophe not followed by a syntax character is just an
+	// apostrophe
+	if p.pos >= len(p.input) || !strings.ContainsRune("{}#|", p.input[p.pos]) {
+		return []rune{'\''}
+	}
+
+	// everything up to the next lone apostrophe is quoted literal text
+	quoted := []rune{}
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		p.pos++
+		if r == '\'' {
+			if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+				quoted = append(quoted, '\'')
+				p.pos++
+				continue
+			}
+			break
+		}
+		quoted = append(quoted, r)
+	}
+
+	return quoted
+}
+
+// parseArgument parses an argument starting at an opening brace - either a
+// simple {name} argument, or one of the complex argument types.
+func (p *messageParser) parseArgument() (*messagePart, error) {
+	p.pos++ // skip {
+	p.skipSpace()
+
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.error("missing argument name")
+	}
+
+	p.skipSpace()
+	if p.consume('}') {
+		return &messagePart{partType: messagePartArgument, name: name}, nil
+	}
+
+	if !p.consume(',') {
+		return nil, p.error("expected , or } after argument name " + name)
+	}
+
+	p.skipSpace()
+	argType := p.parseIdentifier()
+	p.skipSpace()
+
+	switch argType {
+	case "number":
+		part := &messagePart{partType: messagePartNumber, name: name}
+		if p.consume(',') {
+			p.skipSpace()
+			part.style = p.parseIdentifier()
+			p.skipSpace()
+		}
+		if !p.consume('}') {
+			return nil, p.error("expected } after number argument " + name)
+		}
+		return part, nil
+	case "plural":
+		return p.parseOptions(&messagePart{partType: messagePartPlural, name: name}, true)
+	case "selectordinal":
+		return p.parseOptions(&messagePart{partType: messagePartSelectOrdinal, name: name}, true)
+	case "select":
+		return p.parseOptions(&messagePart{partType: messagePartSelect, name: name}, false)
+	}
+
+	return nil, p.error("unsupported argument type: " + argType)
+}
+
+// parseOptions parses the style portion of a plural, selectordinal or select
+// argument - the optional offset, followed by the selector {message} pairs.
+func (p *messageParser) parseOptions(part *messagePart, isPlural bool) (*messagePart, error) {
+	if !p.consume(',') {
+		return nil, p.error("expected , after argument type for " + part.name)
+	}
+
+	part.options = map[string][]*messagePart{}
+
+	for {
+		p.skipSpace()
+
+		if p.consume('}') {
+			break
+		}
+
+		if p.pos >= len(p.input) {
+			return nil, p.error("unclosed argument " + part.name)
+		}
+
+		selector := p.parseSelector()
+		if selector == "" {
+			return nil, p.error("missing selector in argument " + part.name)
+		}
+
+		if isPlural && strings.HasPrefix(selector, "offset:") {
+			offset, err := strconv.ParseFloat(strings.TrimPrefix(selector, "offset:"), 64)
+			if err != nil || len(part.options) > 0 {
+				return nil, p.error("invalid offset in argument " + part.name)
+			}
+			part.offset = offset
+			continue
+		}
+
+		p.skipSpace()
+		if !p.consume('{') {
+			return nil, p.error("expected { after selector " + selector)
+		}
+
+		sub, err := p.parseMessage(true, isPlural)
+		if err != nil {
+			return nil, err
+		}
+		p.pos++ // skip }
+
+		part.options[selector] = sub
+	}
+
+	if _, ok := part.options[messageFormatOther]; !ok {
+		return nil, p.error("missing other selector in argument " + part.name)
+	}
+
+	return part, nil
+}
+
+// parseIdentifier reads an argument name or keyword
+func (p *messageParser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// parseSelector reads a selector keyword, which can include = and : (for
+// explicit values and offsets)
+func (p *messageParser) parseSelector() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		if unicode.IsSpace(r) || r == '{' || r == '}' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// skipSpace advances past any whitespace
+func (p *messageParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// consume advances past the expected rune if it is at the current position
+func (p *messageParser) consume(r rune) bool {
+	if p.pos < len(p.input) && p.input[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// error returns a translatorError which includes the parser position
+func (p *messageParser) error(message string) error {
+	return translatorError{message: "invalid message format at position " + strconv.Itoa(p.pos) + ": " + message}
+}
+
+// messageArgumentNames returns the names of all arguments used anywhere in a
+// parsed message, including those nested inside sub messages
+func messageArgumentNames(parts []*messagePart, names map[string]bool) {
+	for _, part := range parts {
+		if part.name != "" {
+			names[part.name] = true
+		}
+		for _, sub := range part.options {
+			messageArgumentNames(sub, names)
+		}
+	}
+}
+
+// formatMessage parses an ICU MessageFormat message and renders it using the
+// values in the substitutions map. Plural and selectordinal arguments must
+// have a numeric value, the plural category of which is chosen using the
+// locale's plural or ordinal rules. Simple arguments without a value are left
+// in the message untouched, and substitutions that are not used in the message
+// are reported as errors.
+func (t *Translator) formatMessage(message string, substitutions map[string]string) (formatted string, errors []error) {
+	parts, err := parseMessageFormat(message)
+	if err != nil {
+		errors = append(errors, translatorError{translator: t, message: err.Error()})
+		var errs []error
+		formatted, errs = t.substitute(message, substitutions)
+		for _, err := range errs {
+			errors = append(errors, err)
+		}
+		return
+	}
+
+	names := map[string]bool{}
+	messageArgumentNames(parts, names)
+	for find, replace := range substitutions {
+		if !names[find] {
+			errors = append(errors, translatorError{translator: t, message: "substitution not found: " + message + ", " + replace})
+		}
+	}
+
+	formatted, errs := t.renderMessage(parts, substitutions, "")
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// renderMessage renders a sequence of parsed message parts. The number string
+// is what a # is replaced with inside plural sub messages.
+func (t *Translator) renderMessage(parts []*messagePart, substitutions map[string]string, number string) (rendered string, errors []error) {
+
+	for _, part := range parts {
+		switch part.partType {
+		case messagePartLiteral:
+			rendered += part.text
+		case messagePartNumberSign:
+			rendered += number
+		case messagePartArgument:
+			if value, ok := substitutions[part.name]; ok {
+				rendered += value
+			} else {
+				rendered += "{" + part.name + "}"
+			}
+		case messagePartNumber:
+			value, ok := substitutions[part.name]
+			if !ok {
+				errors = append(errors, translatorError{translator: t, message: "missing number argument: " + part.name})
+				continue
+			}
+			rendered += t.formatMessageNumber(value, part.style)
+		case messagePartPlural, messagePartSelectOrdinal, messagePartSelect:
+			r, errs := t.renderMessageOptions(part, substitutions)
+			rendered += r
+			for _, err := range errs {
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	return
+}
+
+// renderMessageOptions chooses and renders the sub message of a plural,
+// selectordinal or select argument
+func (t *Translator) renderMessageOptions(part *messagePart, substitutions map[string]string) (rendered string, errors []error) {
+	value, ok := substitutions[part.name]
+	if !ok {
+		errors = append(errors, translatorError{translator: t, message: "missing argument: " + part.name})
+	}
+
+	if part.partType == messagePartSelect {
+		sub, ok := part.options[value]
+		if !ok {
+			sub = part.options[messageFormatOther]
+		}
+		return t.renderMessage(sub, substitutions, "")
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil && ok {
+		errors = append(errors, translatorError{translator: t, message: "argument is not a number: " + part.name + ", " + value})
+	}
+
+	// the number is displayed in the locale's decimal format, with the
+	// offset applied, whether or not there is one
+	numberStr := value
+	if err == nil {
+		numberStr = t.formatMessagePluralNumber(number-part.offset, value)
+	}
+
+	// explicit values are matched before the offset is applied. plural
+	// categories use the displayed number, so visible fraction digits count
+	var sub []*messagePart
+	found := false
+	if err == nil {
+		sub, found = part.options["="+strconv.FormatFloat(number, 'f', -1, 64)]
+
+		operands := t.rules.pluralOperands(number-part.offset, numberStr)
+		if !found && part.partType == messagePartPlural {
+			sub, found = part.options[t.rules.pluralOperandsFunc(operands).String()]
+		} else if !found {
+			sub, found = part.options[t.rules.ordinalOperandsFunc(operands).String()]
+		}
+	}
+	if !found {
+		sub = part.options[messageFormatOther]
+	}
+
+	r, errs := t.renderMessage(sub, substitutions, numberStr)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+	rendered = r
+
+	return
+}
+
+// formatMessagePluralNumber formats the number a # is replaced with in the
+// locale's decimal format, keeping the visible fraction digits of the value
+// it was passed as, so "1.0" is still "1.0" rather than "1".
+func (t *Translator) formatMessagePluralNumber(number float64, value string) string {
+	format := *t.parseFormat(t.rules.Numbers.Formats.Decimal, true)
+	if pos := strings.Index(value, "."); pos != -1 {
+		digits := len(value) - pos - 1
+		format.minDecimalDigits = digits
+		if digits > format.maxDecimalDigits {
+			format.maxDecimalDigits = digits
+		}
+	}
+
+	return t.formatNumber(&format, number)
+}
+
+// formatMessageNumber formats a {name, number} argument value according to
+// the optional style - "integer", "percent", or the default decimal format.
+// Values that aren't numbers are returned as is.
+func (t *Translator) formatMessageNumber(value, style string) string {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value
+	}
+
+	switch style {
+	case "integer":
+		return t.FormatNumberWhole(number)
+	case "percent":
+		return t.FormatPercent(number)
+	}
+
+	return t.FormatNumber(number)
+}
@@ -0,0 +1,163 @@
+package i18n
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseMessageFormat(c *C) {
+	parts, err := parseMessageFormat("Hello, {name}!")
+	c.Assert(err, IsNil)
+	c.Assert(parts, HasLen, 3)
+	c.Check(parts[0].partType, Equals, messagePartLiteral)
+	c.Check(parts[0].text, Equals, "Hello, ")
+	c.Check(parts[1].partType, Equals, messagePartArgument)
+	c.Check(parts[1].name, Equals, "name")
+	c.Check(parts[2].text, Equals, "!")
+
+	parts, err = parseMessageFormat("{n, plural, offset:1 =0 {none} one {# thing} other {# things}}")
+	c.Assert(err, IsNil)
+	c.Assert(parts, HasLen, 1)
+	c.Check(parts[0].partType, Equals, messagePartPlural)
+	c.Check(parts[0].offset, Equals, float64(1))
+	c.Check(parts[0].options, HasLen, 3)
+	c.Assert(parts[0].options["one"], HasLen, 2)
+	c.Check(parts[0].options["one"][0].partType, Equals, messagePartNumberSign)
+
+	// # is only special inside of plurals
+	parts, err = parseMessageFormat("{g, select, other {#1}}")
+	c.Assert(err, IsNil)
+	c.Check(parts[0].options["other"][0].text, Equals, "#1")
+
+	// apostrophes
+	parts, err = parseMessageFormat("l'heure '{x}' it''s")
+	c.Assert(err, IsNil)
+	c.Assert(parts, HasLen, 1)
+	c.Check(parts[0].text, Equals, "l'heure {x} it's")
+
+	// malformed messages
+	_, err = parseMessageFormat("{n, plural, one {# thing}}")
+	c.Check(err, NotNil)
+
+	_, err = parseMessageFormat("{n, plural, one {# thing} other {# things}")
+	c.Check(err, NotNil)
+
+	_, err = parseMessageFormat("unbalanced }")
+	c.Check(err, NotNil)
+
+	_, err = parseMessageFormat("{n, date}")
+	c.Check(err, NotNil)
+
+	_, err = parseMessageFormat("{}")
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestFormatMessage(c *C) {
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	tEn, _ := f.GetTranslator("en")
+	c.Assert(tEn, NotNil)
+
+	// plurals, including explicit values
+	m, errors := tEn.Translate("CART_ITEMS", map[string]string{"count": "0"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Your cart is empty")
+
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{"count": "1"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1 item in your cart")
+
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{"count": "1.0"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1.0 items in your cart")
+
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{"count": "1.5"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1.5 items in your cart")
+
+	// # is in the locale's number format, with or without an offset
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{"count": "1000"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1,000 items in your cart")
+
+	// plurals with an offset and nested arguments
+	m, errors = tEn.Translate("PARTY_GUESTS", map[string]string{"host": "Ann", "guests": "1", "guest": "Bob"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Ann invited Bob")
+
+	m, errors = tEn.Translate("PARTY_GUESTS", map[string]string{"host": "Ann", "guests": "2", "guest": "Bob"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Ann invited Bob and 1 other person")
+
+	m, errors = tEn.Translate("PARTY_GUESTS", map[string]string{"host": "Ann", "guests": "1235", "guest": "Bob"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Ann invited Bob and 1,234 other people")
+
+	// select
+	m, errors = tEn.Translate("PHOTO_LIKED", map[string]string{"gender": "female"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "She liked your photo")
+
+	m, errors = tEn.Translate("PHOTO_LIKED", map[string]string{"gender": "unknown"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "They liked your photo")
+
+	// selectordinal
+	m, errors = tEn.Translate("RANKING", map[string]string{"place": "1"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "You finished first")
+
+	m, errors = tEn.Translate("RANKING", map[string]string{"place": "22"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "You finished 22nd")
+
+	m, errors = tEn.Translate("RANKING", map[string]string{"place": "13"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "You finished 13th")
+
+	// quoting
+	m, errors = tEn.Translate("QUOTED", map[string]string{})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "Use {braces} for placeholders, don't forget")
+
+	// missing and invalid plural values fall back to other
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{})
+	c.Check(errors, HasLen, 1)
+	c.Check(m, Equals, " items in your cart")
+
+	m, errors = tEn.Translate("CART_ITEMS", map[string]string{"count": "many"})
+	c.Check(errors, HasLen, 1)
+	c.Check(m, Equals, "many items in your cart")
+
+	// unused substitutions are reported
+	m, errors = tEn.Translate("PHOTO_LIKED", map[string]string{"gender": "male", "extra": "value"})
+	c.Check(errors, HasLen, 1)
+	c.Check(m, Equals, "He liked your photo")
+
+	// number arguments
+	m, errors = tEn.formatMessage("{a, number} {a, number, integer} {b, number, percent} {c, number}", map[string]string{"a": "1234.5", "b": "0.25", "c": "abc"})
+	c.Check(errors, HasLen, 0)
+	c.Check(m, Equals, "1,234.5 1,234 25% abc")
+
+	// invalid messages fall back to simple substitution
+	m, errors = tEn.formatMessage("Hi {name} }", map[string]string{"name": "Bob"})
+	c.Check(errors, HasLen, 1)
+	c.Check(m, Equals, "Hi Bob }")
+
+	// plural categories come from the locale's plural rules
+	tAr, _ := f.GetTranslator("ar")
+	c.Assert(tAr, NotNil)
+
+	message := "{n, plural, zero {z} one {o} two {t} few {f} many {m} other {x}}"
+	for n, expected := range map[string]string{"0": "z", "1": "o", "2": "t", "3": "f", "11": "m", "100": "x"} {
+		m, errors = tAr.formatMessage(message, map[string]string{"n": n})
+		c.Check(errors, HasLen, 0)
+		c.Check(m, Equals, expected)
+	}
+}
@@ -0,0 +1,215 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// LocaleResolver finds the languages a request asks for, like from its URL or
+// its Accept-Language header
+type LocaleResolver struct {
+	// Languages returns the request's preferred languages, most preferred
+	// first, or nil if it doesn't ask for any
+	Languages func(r *http.Request) []string
+
+	// Vary is the request header the languages come from, if any. The
+	// middleware adds it to the response's Vary header, so caches know the
+	// response depends on it.
+	Vary string
+}
+
+// LocaleFromPath resolves the locale from the first segment of the request's
+// URL path, like "fr-CA" in "/fr-CA/checkout". Only the locales passed to it
+// are resolved, so that paths like "/checkout" or "/api" aren't looked up as
+// locales.
+func LocaleFromPath(locales ...string) LocaleResolver {
+	known := map[string]bool{}
+	for _, locale := range locales {
+		known[CanonicalLocale(locale)] = true
+	}
+
+	return LocaleResolver{
+		Languages: func(r *http.Request) []string {
+			segment := strings.TrimPrefix(r.URL.Path, "/")
+			if pos := strings.Index(segment, "/"); pos != -1 {
+				segment = segment[:pos]
+			}
+			if !isLanguageTag(segment) {
+				return nil
+			}
+			if _, err := language.Parse(segment); err != nil || !known[CanonicalLocale(segment)] {
+				return nil
+			}
+			return []string{segment}
+		},
+	}
+}
+
+// LocaleFromQuery resolves the locale from a query parameter, like "lang" in
+// "/checkout?lang=fr-CA"
+func LocaleFromQuery(param string) LocaleResolver {
+	return LocaleResolver{
+		Languages: func(r *http.Request) []string {
+			if language := r.URL.Query().Get(param); language != "" {
+				return []string{language}
+			}
+			return nil
+		},
+	}
+}
+
+// LocaleFromCookie resolves the locale from a cookie, like one set when the
+// user picks a language
+func LocaleFromCookie(name string) LocaleResolver {
+	return LocaleResolver{
+		Languages: func(r *http.Request) []string {
+			if cookie, err := r.Cookie(name); err == nil && cookie.Value != "" {
+				return []string{cookie.Value}
+			}
+			return nil
+		},
+		Vary: "Cookie",
+	}
+}
+
+// LocaleFromAcceptLanguage resolves the locale from the request's
+// Accept-Language header, the same way as Negotiate
+func LocaleFromAcceptLanguage() LocaleResolver {
+	return LocaleResolver{
+		Languages: func(r *http.Request) []string {
+			return parseAcceptLanguage(r.Header.Get("Accept-Language"))
+		},
+		Vary: "Accept-Language",
+	}
+}
+
+// Middleware returns net/http middleware which picks a Translator for each
+// request, and stores it in the request's context, where handlers can get it
+// with FromContext. The resolvers are tried in order, and the first language
+// the factory has messages for - or a less specific version of it - is used.
+// If none of the resolvers find one, the factory's fallback Translator is
+// used. Without any resolvers, the locale is resolved from the "lang" query
+// parameter, the "lang" cookie and then the Accept-Language header. Pass
+// LocaleFromPath, with the locales your paths start with, to resolve it from
+// the URL path too.
+//
+// The middleware sets the response's Content-Language header to the locale,
+// and adds the headers the locale depends on to its Vary header.
+//
+//     mux := http.NewServeMux()
+//     mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//         t := i18n.FromContext(r.Context())
+//         welcome, _ := t.Translate("WELCOME", map[string]string{})
+//         fmt.Fprintln(w, welcome)
+//     })
+//
+//     http.ListenAndServe(":8080", f.Middleware()(mux))
+func (f *TranslatorFactory) Middleware(resolvers ...LocaleResolver) func(http.Handler) http.Handler {
+	if len(resolvers) == 0 {
+		resolvers = []LocaleResolver{
+			LocaleFromQuery("lang"),
+			LocaleFromCookie("lang"),
+			LocaleFromAcceptLanguage(),
+		}
+	}
+
+	vary := []string{}
+	for _, resolver := range resolvers {
+		if resolver.Vary != "" {
+			vary = append(vary, resolver.Vary)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			languages := []string{}
+			for _, resolver := range resolvers {
+				for _, language := range resolver.Languages(r) {
+					if isLanguageTag(language) {
+						languages = append(languages, language)
+					}
+				}
+			}
+
+			t, _, _, _ := f.NegotiateLanguages(languages)
+
+			for _, header := range vary {
+				addVary(w.Header(), header)
+			}
+
+			if t != nil {
+				w.Header().Set("Content-Language", t.locale)
+				r = r.WithContext(NewContext(r.Context(), t))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// translatorContextKey is the context key the middleware stores Translators
+// under
+type translatorContextKey struct{}
+
+// NewContext returns a copy of a context which carries a Translator
+func NewContext(ctx context.Context, t *Translator) context.Context {
+	return context.WithValue(ctx, translatorContextKey{}, t)
+}
+
+// FromContext returns the Translator stored in a context by the middleware or
+// NewContext, or nil if there isn't one
+func FromContext(ctx context.Context) *Translator {
+	t, _ := ctx.Value(translatorContextKey{}).(*Translator)
+	return t
+}
+
+// LocaleFromContext returns the locale of the Translator stored in a context,
+// or "" if there isn't one
+func LocaleFromContext(ctx context.Context) string {
+	if t := FromContext(ctx); t != nil {
+		return t.locale
+	}
+	return ""
+}
+
+// addVary adds a header to a response's Vary header, unless it's already there
+func addVary(header http.Header, name string) {
+	for _, value := range header.Values("Vary") {
+		for _, existing := range strings.Split(value, ",") {
+			existing = strings.TrimSpace(existing)
+			if existing == "*" || strings.EqualFold(existing, name) {
+				return
+			}
+		}
+	}
+	header.Add("Vary", name)
+}
+
+// isLanguageTag returns whether a string looks like a language tag - subtags
+// of 1 to 8 letters and digits separated by "-" or "_", starting with a
+// letter. Only these are looked up, so that requests can't make sources look
+// for arbitrary file names or URLs.
+func isLanguageTag(language string) bool {
+	if language == "" || len(language) > 64 {
+		return false
+	}
+
+	for i, subtag := range strings.FieldsFunc(language, func(r rune) bool { return r == '-' || r == '_' }) {
+		if len(subtag) > 8 {
+			return false
+		}
+		for j, r := range subtag {
+			isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+			isDigit := r >= '0' && r <= '9'
+			if !isLetter && !(isDigit && (i > 0 || j > 0)) {
+				return false
+			}
+		}
+	}
+
+	language = strings.Replace(language, "_", "-", -1)
+	return language[0] != '-' && language[len(language)-1] != '-' && !strings.Contains(language, "--")
+}
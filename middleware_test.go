@@ -0,0 +1,115 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestMiddleware(c *C) {
+	m := NewMemorySource()
+	for _, locale := range []string{"en", "fr", "de"} {
+		m.AddMessages(locale, map[string]interface{}{"LOCALE": locale})
+	}
+
+	f, errors := NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	var handled *Translator
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handled = FromContext(r.Context())
+		w.Header().Add("Vary", "Accept-Encoding")
+	})
+
+	tests := []struct {
+		url            string
+		cookie         string
+		acceptLanguage string
+		locale         string
+	}{
+		{"/fr/checkout", "de", "de", "de"},
+		{"/checkout?lang=de", "fr", "fr", "de"},
+		{"/checkout", "fr", "de", "fr"},
+		{"/checkout", "", "es, de;q=0.8", "de"},
+		{"/checkout", "", "es", "en"},
+		{"/", "", "", "en"},
+		{"/../checkout?lang=..", "..%2F", "../fr", "en"},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", test.url, nil)
+		if test.cookie != "" {
+			r.AddCookie(&http.Cookie{Name: "lang", Value: test.cookie})
+		}
+		if test.acceptLanguage != "" {
+			r.Header.Set("Accept-Language", test.acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+
+		handled = nil
+		f.Middleware()(handler).ServeHTTP(w, r)
+
+		c.Assert(handled, NotNil, Commentf(test.url))
+		c.Check(handled.Locale(), Equals, test.locale, Commentf(test.url))
+		c.Check(w.Header().Get("Content-Language"), Equals, test.locale, Commentf(test.url))
+		c.Check(w.Header().Values("Vary"), DeepEquals, []string{"Cookie", "Accept-Language", "Accept-Encoding"}, Commentf(test.url))
+	}
+
+	// paths are only resolved for the locales they're given
+	for path, locale := range map[string]string{
+		"/fr/checkout":    "fr",
+		"/fr-CA/checkout": "fr-CA",
+		"/de-CH/checkout": "en",
+		"/checkout":       "en",
+		"/api/v1":         "en",
+	} {
+		r := httptest.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+
+		f.Middleware(LocaleFromPath("en", "fr", "fr_ca", "de"))(handler).ServeHTTP(w, r)
+		c.Check(handled.Locale(), Equals, locale, Commentf(path))
+		c.Check(w.Header().Values("Vary"), DeepEquals, []string{"Accept-Encoding"}, Commentf(path))
+	}
+
+	// a custom chain
+	r := httptest.NewRequest("GET", "/fr/checkout?locale=de", nil)
+	r.Header.Set("Accept-Language", "fr")
+	w := httptest.NewRecorder()
+	w.Header().Set("Vary", "accept-language")
+
+	f.Middleware(LocaleFromQuery("locale"), LocaleFromAcceptLanguage())(handler).ServeHTTP(w, r)
+	c.Check(handled.Locale(), Equals, "de")
+	c.Check(w.Header().Values("Vary"), DeepEquals, []string{"accept-language", "Accept-Encoding"})
+
+	// without a fallback, requests for missing locales don't get a translator
+	f, _ = NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "")
+	r = httptest.NewRequest("GET", "/checkout", nil)
+	w = httptest.NewRecorder()
+
+	f.Middleware()(handler).ServeHTTP(w, r)
+	c.Check(handled, IsNil)
+	c.Check(w.Header().Get("Content-Language"), Equals, "")
+}
+
+func (s *MySuite) TestFromContext(c *C) {
+	ctx := context.Background()
+	c.Check(FromContext(ctx), IsNil)
+	c.Check(LocaleFromContext(ctx), Equals, "")
+
+	t := &Translator{locale: "fr"}
+	ctx = NewContext(ctx, t)
+	c.Check(FromContext(ctx), Equals, t)
+	c.Check(LocaleFromContext(ctx), Equals, "fr")
+}
+
+func (s *MySuite) TestIsLanguageTag(c *C) {
+	for _, tag := range []string{"en", "fr-CA", "zh-Hant-TW", "en_US", "es-419", "de-CH-1996", "x-klingon"} {
+		c.Check(isLanguageTag(tag), Equals, true, Commentf(tag))
+	}
+
+	for _, tag := range []string{"", "*", "..", "en/..", "-en", "en-", "en--US", "1en", "en-toolongsubtag", "fr.yaml", "en US"} {
+		c.Check(isLanguageTag(tag), Equals, false, Commentf(tag))
+	}
+}
@@ -0,0 +1,169 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Confidence is how well the locale picked by Negotiate matches the preferred
+// languages
+type Confidence int
+
+const (
+	// ConfidenceNone means no locale was found, and there's no fallback
+	ConfidenceNone Confidence = iota
+
+	// ConfidenceLow means none of the preferred languages were found, so the
+	// factory's fallback locale was picked
+	ConfidenceLow
+
+	// ConfidenceHigh means a less specific version of a preferred language was
+	// found, like "fr" for "fr-CA"
+	ConfidenceHigh
+
+	// ConfidenceExact means a preferred language was found exactly
+	ConfidenceExact
+)
+
+// String returns the name of the confidence level
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceHigh:
+		return "high"
+	case ConfidenceExact:
+		return "exact"
+	}
+	return "none"
+}
+
+// Negotiate picks the best Translator for an Accept-Language header, like
+// "fr-CA, fr;q=0.9, en;q=0.5". Languages are tried in the order of their
+// q-values, and languages with q=0 are ignored. It returns the Translator,
+// the locale it's for, and how well that locale matches the header.
+func (f *TranslatorFactory) Negotiate(acceptLanguage string) (t *Translator, locale string, confidence Confidence, errors []error) {
+	return f.NegotiateLanguages(parseAcceptLanguage(acceptLanguage))
+}
+
+// NegotiateLanguages picks the best Translator for a list of preferred
+// languages, most preferred first. Each language is canonicalized, and then it
+// looks for the language itself and then its less specific versions, the same
+// way GetTranslator finds fallbacks - "zh-Hant-TW", then "zh-Hant", then "zh".
+// The first one with messages is picked, unless a more specific one has rules
+// of its own, like "en-GB" with messages for "en", in which case that one is
+// picked, so its date and number formats are used. If none of them have
+// messages, the factory's fallback Translator is returned. It returns the
+// Translator, the locale it's for, and how well that locale matches the
+// preferred languages.
+func (f *TranslatorFactory) NegotiateLanguages(languages []string) (t *Translator, locale string, confidence Confidence, errors []error) {
+	for _, language := range languages {
+		if language == "*" {
+			continue
+		}
+
+		locale = CanonicalLocale(language)
+		chain := append([]string{locale}, localeParents(locale)...)
+		found := -1
+		for i, candidate := range chain {
+			exists, errs := f.LocaleExists(candidate)
+			for _, err := range errs {
+				errors = append(errors, err)
+			}
+
+			if exists {
+				found = i
+				break
+			}
+		}
+
+		if found == -1 {
+			continue
+		}
+
+		// prefer a more specific locale with rules of its own
+		for i, candidate := range chain[:found] {
+			exists, errs := f.hasRules(candidate)
+			for _, err := range errs {
+				errors = append(errors, err)
+			}
+
+			if exists {
+				found = i
+				break
+			}
+		}
+
+		locale = chain[found]
+		confidence = ConfidenceExact
+		if found > 0 {
+			confidence = ConfidenceHigh
+		}
+
+		// the warning that a locale with only rules has no messages files
+		// isn't useful here
+		t, errs := f.GetTranslator(locale)
+		for _, err := range errs {
+			if !IsWarning(err) {
+				errors = append(errors, err)
+			}
+		}
+		return t, locale, confidence, errors
+	}
+
+	f.mutex.RLock()
+	t = f.fallback
+	f.mutex.RUnlock()
+
+	if t == nil {
+		return nil, "", ConfidenceNone, errors
+	}
+
+	return t, t.locale, ConfidenceLow, errors
+}
+
+// parseAcceptLanguage returns the languages in an Accept-Language header,
+// sorted by their q-values. Languages with the same q-value keep their order,
+// and languages with q=0 or an invalid q-value are left out.
+func parseAcceptLanguage(header string) (languages []string) {
+	type weightedLanguage struct {
+		language string
+		q        float64
+	}
+
+	weighted := []weightedLanguage{}
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		language := strings.TrimSpace(params[0])
+		if language == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if len(param) > 2 && strings.ToLower(param[:2]) == "q=" {
+				var err error
+				q, err = strconv.ParseFloat(param[2:], 64)
+				if err != nil || q < 0 || q > 1 {
+					q = 0
+				}
+			}
+		}
+
+		if q > 0 {
+			weighted = append(weighted, weightedLanguage{language, q})
+		}
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].q > weighted[j].q
+	})
+
+	for _, w := range weighted {
+		languages = append(languages, w.language)
+	}
+
+	return
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestNegotiate(c *C) {
+	m := NewMemorySource()
+	for _, locale := range []string{"en", "fr", "pt-BR", "zh-Hant"} {
+		m.AddMessages(locale, map[string]interface{}{"LOCALE": locale})
+	}
+
+	f, errors := NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	tests := []struct {
+		acceptLanguage string
+		locale         string
+		confidence     Confidence
+	}{
+		{"fr", "fr", ConfidenceExact},
+		{"fr-CA, fr;q=0.9, en;q=0.8", "fr-CA", ConfidenceExact},
+		{"en-GB-oxendict", "en-GB", ConfidenceHigh},
+		{"de, fr;q=0.5", "fr", ConfidenceExact},
+		{"de-AT;q=0.5, fr-CH;q=0.4", "fr", ConfidenceHigh},
+		{"en;q=0.5, fr", "fr", ConfidenceExact},
+		{"pt-BR", "pt-BR", ConfidenceExact},
+		{"pt", "en", ConfidenceLow},
+		{"zh-Hant-TW", "zh-Hant", ConfidenceHigh},
+		{"fr-x-private", "fr", ConfidenceHigh},
+		{"fr;q=0, de", "en", ConfidenceLow},
+		{"*", "en", ConfidenceLow},
+		{"", "en", ConfidenceLow},
+	}
+
+	for _, test := range tests {
+		t, locale, confidence, errors := f.Negotiate(test.acceptLanguage)
+		c.Check(errors, HasLen, 0, Commentf(test.acceptLanguage))
+		c.Check(locale, Equals, test.locale, Commentf(test.acceptLanguage))
+		c.Check(confidence, Equals, test.confidence, Commentf(test.acceptLanguage))
+		c.Assert(t, NotNil, Commentf(test.acceptLanguage))
+		c.Check(t.locale, Equals, test.locale, Commentf(test.acceptLanguage))
+	}
+
+	t, locale, confidence, _ := f.NegotiateLanguages([]string{"de-AT", "zh-Hant", "fr"})
+	c.Assert(t, NotNil)
+	c.Check(locale, Equals, "zh-Hant")
+	c.Check(confidence, Equals, ConfidenceExact)
+
+	translation, _ := t.Translate("LOCALE", map[string]string{})
+	c.Check(translation, Equals, "zh-Hant")
+
+	// without a fallback, nothing is found
+	f, _ = NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "")
+	t, locale, confidence, _ = f.Negotiate("de")
+	c.Check(t, IsNil)
+	c.Check(locale, Equals, "")
+	c.Check(confidence, Equals, ConfidenceNone)
+	c.Check(confidence.String(), Equals, "none")
+}
+
+func (s *MySuite) TestParseAcceptLanguage(c *C) {
+	c.Check(parseAcceptLanguage("da, en-gb;q=0.8, en;q=0.7"), DeepEquals, []string{"da", "en-gb", "en"})
+	c.Check(parseAcceptLanguage("en;q=0.7,de ; Q=0.9 , fr"), DeepEquals, []string{"fr", "de", "en"})
+	c.Check(parseAcceptLanguage("en;q=0.5, fr;q=0.5, de;q=0.5"), DeepEquals, []string{"en", "fr", "de"})
+	c.Check(parseAcceptLanguage("en;q=0, fr;q=abc, de;q=2, es"), DeepEquals, []string{"es"})
+	c.Check(parseAcceptLanguage(" , ;q=1"), IsNil)
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // numberFormat is a struct that contains all the information about number
@@ -33,6 +34,10 @@ var (
 	numberFormats           = map[string]*numberFormat{}
 	numberFormatsNoDecimals = map[string]*numberFormat{}
 
+	// numberFormatsMutex guards numberFormats and numberFormatsNoDecimals, so
+	// translators can format numbers concurrently
+	numberFormatsMutex sync.RWMutex
+
 	// prefixSuffixRegex is a regular expression that is used to parse number
 	// formats
 	prefixSuffixRegex = regexp.MustCompile(`(.*?)[#,\.0]+(.*)`)
@@ -95,11 +100,13 @@ func (t *Translator) FormatPercent(number float64) string {
 func (t *Translator) parseFormat(pattern string, includeDecimalDigits bool) *numberFormat {
 
 	processed := false
+	numberFormatsMutex.RLock()
 	if includeDecimalDigits {
 		_, processed = numberFormats[pattern]
 	} else {
 		_, processed = numberFormatsNoDecimals[pattern]
 	}
+	numberFormatsMutex.RUnlock()
 
 	if !processed {
 
@@ -180,6 +187,7 @@ func (t *Translator) parseFormat(pattern string, includeDecimalDigits bool) *num
 			}
 		}
 
+		numberFormatsMutex.Lock()
 		if includeDecimalDigits {
 			numberFormats[pattern] = format
 		} else {
@@ -187,9 +195,13 @@ func (t *Translator) parseFormat(pattern string, includeDecimalDigits bool) *num
 			format.minDecimalDigits = 0
 			numberFormatsNoDecimals[pattern] = format
 		}
+		numberFormatsMutex.Unlock()
 
 	}
 
+	numberFormatsMutex.RLock()
+	defer numberFormatsMutex.RUnlock()
+
 	if includeDecimalDigits {
 		return numberFormats[pattern]
 	}
@@ -1,6 +1,10 @@
 package i18n
 
-import . "gopkg.in/check.v1"
+import (
+	"sync"
+
+	. "gopkg.in/check.v1"
+)
 
 func (s *MySuite) TestFormatCurrency(c *C) {
 
@@ -478,3 +482,38 @@ func (s *MySuite) TestNumberRound(c *C) {
 	rounded = numberRound(num, dec)
 	c.Check(rounded, Equals, "0.09")
 }
+
+func (s *MySuite) TestFormatNumberConcurrent(c *C) {
+	f, errors := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+	c.Check(errors, HasLen, 0)
+
+	locales := []string{"en", "fr", "de", "ar", "hi", "ja", "ru", "pt-br"}
+	expected := map[string]string{}
+	for _, locale := range locales {
+		t, _ := f.GetTranslator(locale)
+		expected[locale] = t.FormatNumber(1234567.891)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for _, locale := range locales {
+				t, _ := f.GetTranslator(locale)
+				c.Check(t.FormatNumber(1234567.891), Equals, expected[locale])
+				t.FormatNumberWhole(-1234567.891)
+				t.FormatPercent(0.25)
+				t.FormatCurrency(-12.5, "USD")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,440 @@
+package i18n
+
+// ordinalRules contains the CLDR plural rule conditions for each of the named
+// ordinal rules. The string map index is used when loading ordinal rules from
+// yaml files, and the conditions are compiled into a pluralRule at that time.
+// Ordinal rules choose the plural category for ordinal numbers, like the
+// "st", "nd", "rd" and "th" in English "1st", "2nd", "3rd" and "4th".
+var ordinalRules = map[string]map[string]string{
+	"1":  ordinalRule1,
+	"2A": ordinalRule2A,
+	"2B": ordinalRule2B,
+	"2C": ordinalRule2C,
+	"2D": ordinalRule2D,
+	"2E": ordinalRule2E,
+	"2F": ordinalRule2F,
+	"3A": ordinalRule3A,
+	"3B": ordinalRule3B,
+	"4A": ordinalRule4A,
+	"4B": ordinalRule4B,
+	"4C": ordinalRule4C,
+	"4D": ordinalRule4D,
+	"5A": ordinalRule5A,
+	"5B": ordinalRule5B,
+	"6A": ordinalRule6A,
+}
+
+// ordinalRule1:
+// Rules for calculating the ordinal plural for languages with no ordinal
+// plurals. This is the default for locales that don't specify an ordinal rule.
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 1 form:
+//     - other:
+//         - rule:     everything
+//         - examples: 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, …
+//
+// Languages:
+//     - de:  German
+//     - es:  Spanish
+//     - ja:  Japanese
+//     - pt:  Portuguese
+//     - ru:  Russian
+//     - zh:  Chinese
+//     - and many others
+var ordinalRule1 = map[string]string{}
+
+// ordinalRule2A:
+// Rules for calculating the ordinal plural for French or languages who share
+// the same rules as French
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - fil: Filipino
+//     - fr:  French
+//     - ga:  Irish
+//     - hy:  Armenian
+//     - lo:  Lao
+//     - ms:  Malay
+//     - ro:  Romanian
+//     - tl:  Tagalog
+//     - vi:  Vietnamese
+var ordinalRule2A = map[string]string{
+	"one": "n = 1",
+}
+
+// ordinalRule2B:
+// Rules for calculating the ordinal plural for Hungarian
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - one:
+//         - rule:     n in 1,5
+//         - examples: 1, 5
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 2, 3, 4, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - hu:  Hungarian
+var ordinalRule2B = map[string]string{
+	"one": "n = 1,5",
+}
+
+// ordinalRule2C:
+// Rules for calculating the ordinal plural for Swedish
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - one:
+//         - rule:     n mod 10 in 1,2 and n mod 100 not in 11,12
+//         - examples: 1, 2, 21, 22, 31, 32, 41, 42, 51, 52, 61, 62, 71, 72, 81, 82, 101, 1001, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - sv:  Swedish
+var ordinalRule2C = map[string]string{
+	"one": "n % 10 = 1,2 and n % 100 != 11,12",
+}
+
+// ordinalRule2D:
+// Rules for calculating the ordinal plural for Italian
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - many:
+//         - rule:     n in 11,8,80,800
+//         - examples: 8, 11, 80, 800
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 1, 2, 3, 4, 5, 6, 7, 9, 10, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - it:  Italian
+var ordinalRule2D = map[string]string{
+	"many": "n = 11,8,80,800",
+}
+
+// ordinalRule2E:
+// Rules for calculating the ordinal plural for Kazakh
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - many:
+//         - rule:     n mod 10 is 6 or n mod 10 is 9 or n mod 10 is 0 and n is not 0
+//         - examples: 6, 9, 10, 16, 19, 20, 26, 29, 30, 36, 39, 40, 100, 1000, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 1, 2, 3, 4, 5, 7, 8, 11, 12, 13, 14, 15, 17, 18, 21, 101, 1001, …
+//
+// Languages:
+//     - kk:  Kazakh
+var ordinalRule2E = map[string]string{
+	"many": "n % 10 = 6 or n % 10 = 9 or n % 10 = 0 and n != 0",
+}
+
+// ordinalRule2F:
+// Rules for calculating the ordinal plural for Nepali
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 2 forms:
+//     - one:
+//         - rule:     n in 1..4
+//         - examples: 1, 2, 3, 4
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - ne:  Nepali
+var ordinalRule2F = map[string]string{
+	"one": "n = 1..4",
+}
+
+// ordinalRule3A:
+// Rules for calculating the ordinal plural for Georgian
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 3 forms:
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - many:
+//         - rule:     n is 0 or n mod 100 in 2..20,40,60,80
+//         - examples: 0, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, …
+//
+// Languages:
+//     - ka:  Georgian
+var ordinalRule3A = map[string]string{
+	"one":  "n = 1",
+	"many": "n = 0 or n % 100 = 2..20,40,60,80",
+}
+
+// ordinalRule3B:
+// Rules for calculating the ordinal plural for Albanian
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 3 forms:
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - many:
+//         - rule:     n mod 10 is 4 and n mod 100 is not 14
+//         - examples: 4, 24, 34, 44, 54, 64, 74, 84, 104, 1004, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 2, 3, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - sq:  Albanian
+var ordinalRule3B = map[string]string{
+	"one":  "n = 1",
+	"many": "n % 10 = 4 and n % 100 != 14",
+}
+
+// ordinalRule4A:
+// Rules for calculating the ordinal plural for English
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 4 forms:
+//     - one:
+//         - rule:     n mod 10 is 1 and n mod 100 is not 11
+//         - examples: 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …
+//     - two:
+//         - rule:     n mod 10 is 2 and n mod 100 is not 12
+//         - examples: 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002, …
+//     - few:
+//         - rule:     n mod 10 is 3 and n mod 100 is not 13
+//         - examples: 3, 23, 33, 43, 53, 63, 73, 83, 103, 1003, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - en:  English
+var ordinalRule4A = map[string]string{
+	"one": "n % 10 = 1 and n % 100 != 11",
+	"two": "n % 10 = 2 and n % 100 != 12",
+	"few": "n % 10 = 3 and n % 100 != 13",
+}
+
+// ordinalRule4B:
+// Rules for calculating the ordinal plural for Catalan
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 4 forms:
+//     - one:
+//         - rule:     n in 1,3
+//         - examples: 1, 3
+//     - two:
+//         - rule:     n is 2
+//         - examples: 2
+//     - few:
+//         - rule:     n is 4
+//         - examples: 4
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - ca:  Catalan
+var ordinalRule4B = map[string]string{
+	"one": "n = 1,3",
+	"two": "n = 2",
+	"few": "n = 4",
+}
+
+// ordinalRule4C:
+// Rules for calculating the ordinal plural for Macedonian
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 4 forms:
+//     - one:
+//         - rule:     n mod 10 is 1 and n mod 100 is not 11
+//         - examples: 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001, …
+//     - two:
+//         - rule:     n mod 10 is 2 and n mod 100 is not 12
+//         - examples: 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002, …
+//     - many:
+//         - rule:     n mod 10 in 7,8 and n mod 100 not in 17,18
+//         - examples: 7, 8, 27, 28, 37, 38, 47, 48, 57, 58, 67, 68, 77, 78, 87, 88, 107, …
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 3, 4, 5, 6, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - mk:  Macedonian
+var ordinalRule4C = map[string]string{
+	"one":  "n % 10 = 1 and n % 100 != 11",
+	"two":  "n % 10 = 2 and n % 100 != 12",
+	"many": "n % 10 = 7,8 and n % 100 != 17,18",
+}
+
+// ordinalRule4D:
+// Rules for calculating the ordinal plural for Marathi
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 4 forms:
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - two:
+//         - rule:     n in 2,3
+//         - examples: 2, 3
+//     - few:
+//         - rule:     n is 4
+//         - examples: 4
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - mr:  Marathi
+var ordinalRule4D = map[string]string{
+	"one": "n = 1",
+	"two": "n = 2,3",
+	"few": "n = 4",
+}
+
+// ordinalRule5A:
+// Rules for calculating the ordinal plural for Hindi or languages who share
+// the same rules as Hindi
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 5 forms:
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - two:
+//         - rule:     n in 2,3
+//         - examples: 2, 3
+//     - few:
+//         - rule:     n is 4
+//         - examples: 4
+//     - many:
+//         - rule:     n is 6
+//         - examples: 6
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 5, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - gu:  Gujarati
+//     - hi:  Hindi
+var ordinalRule5A = map[string]string{
+	"one":  "n = 1",
+	"two":  "n = 2,3",
+	"few":  "n = 4",
+	"many": "n = 6",
+}
+
+// ordinalRule5B:
+// Rules for calculating the ordinal plural for Bengali or languages who share
+// the same rules as Bengali
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 5 forms:
+//     - one:
+//         - rule:     n in 1,5,7,8,9,10
+//         - examples: 1, 5, 7, 8, 9, 10
+//     - two:
+//         - rule:     n in 2,3
+//         - examples: 2, 3
+//     - few:
+//         - rule:     n is 4
+//         - examples: 4
+//     - many:
+//         - rule:     n is 6
+//         - examples: 6
+//     - other:
+//         - rule:     everything else
+//         - examples: 0, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - as:  Assamese
+//     - bn:  Bengali
+var ordinalRule5B = map[string]string{
+	"one":  "n = 1,5,7..10",
+	"two":  "n = 2,3",
+	"few":  "n = 4",
+	"many": "n = 6",
+}
+
+// ordinalRule6A:
+// Rules for calculating the ordinal plural for Welsh
+//
+// Ordinal Rules Documented here:
+// http://unicode.org/cldr/trac/browser/trunk/common/supplemental/ordinals.xml
+//
+// This Ordinal Rule contains 6 forms:
+//     - zero:
+//         - rule:     n in 0,7,8,9
+//         - examples: 0, 7, 8, 9
+//     - one:
+//         - rule:     n is 1
+//         - examples: 1
+//     - two:
+//         - rule:     n is 2
+//         - examples: 2
+//     - few:
+//         - rule:     n in 3,4
+//         - examples: 3, 4
+//     - many:
+//         - rule:     n in 5,6
+//         - examples: 5, 6
+//     - other:
+//         - rule:     everything else
+//         - examples: 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, …
+//
+// Languages:
+//     - cy:  Welsh
+var ordinalRule6A = map[string]string{
+	"zero": "n = 0,7,8,9",
+	"one":  "n = 1",
+	"two":  "n = 2",
+	"few":  "n = 3,4",
+	"many": "n = 5,6",
+}
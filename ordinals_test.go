@@ -0,0 +1,196 @@
+package i18n
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestOrdinalRules(c *C) {
+	// every rule must compile, and return at least the "other" category
+	for name, rules := range ordinalRules {
+		_, categories, err := compilePluralRule(rules)
+		c.Check(err, IsNil, Commentf("ordinal rule %s", name))
+		c.Check(categories, Not(HasLen), 0)
+	}
+}
+
+func (s *MySuite) TestOrdinalRule1(c *C) {
+	rule := compileTestRule(c, ordinalRules["1"])
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2A(c *C) {
+	rule := compileTestRule(c, ordinalRules["2A"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2B(c *C) {
+	rule := compileTestRule(c, ordinalRules["2B"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(15)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2C(c *C) {
+	rule := compileTestRule(c, ordinalRules["2C"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(22)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(112)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2D(c *C) {
+	rule := compileTestRule(c, ordinalRules["2D"])
+
+	c.Check(rule(float64(8)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(800)), Equals, pluralCategoryMany)
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(18)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2E(c *C) {
+	rule := compileTestRule(c, ordinalRules["2E"])
+
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(9)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10)), Equals, pluralCategoryMany)
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule2F(c *C) {
+	rule := compileTestRule(c, ordinalRules["2F"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(4)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule3A(c *C) {
+	rule := compileTestRule(c, ordinalRules["3A"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(2)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(40)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(102)), Equals, pluralCategoryMany)
+
+	c.Check(rule(float64(21)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(101)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule3B(c *C) {
+	rule := compileTestRule(c, ordinalRules["3B"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(4)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(24)), Equals, pluralCategoryMany)
+
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule4A(c *C) {
+	rule := compileTestRule(c, ordinalRules["4A"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
+
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(22)), Equals, pluralCategoryTwo)
+
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(23)), Equals, pluralCategoryFew)
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(4)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule4B(c *C) {
+	rule := compileTestRule(c, ordinalRules["4B"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule4C(c *C) {
+	rule := compileTestRule(c, ordinalRules["4C"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(7)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(28)), Equals, pluralCategoryMany)
+
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(17)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule4D(c *C) {
+	rule := compileTestRule(c, ordinalRules["4D"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(3)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(6)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule5A(c *C) {
+	rule := compileTestRule(c, ordinalRules["5A"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule5B(c *C) {
+	rule := compileTestRule(c, ordinalRules["5B"])
+
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(3)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestOrdinalRule6A(c *C) {
+	rule := compileTestRule(c, ordinalRules["6A"])
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
+	c.Check(rule(float64(8)), Equals, pluralCategoryZero)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+}
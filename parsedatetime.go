@@ -0,0 +1,919 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// datetimeName is a locale's name for a value of a datetime unit, like
+// "January" for the month 1
+type datetimeName struct {
+	value int
+	name  string
+}
+
+// datetimeParser holds the values read from a datetime string, and what's
+// left of the string to read
+type datetimeParser struct {
+	t   *Translator
+	str string
+
+	year, month, day                 int
+	hour, minute, second, nanosecond int
+	dayOfYear, modifiedJulianDay     int
+	millisecondsInDay                int
+
+	// period is "am", "pm", or the key of one of the locale's day period
+	// rules, like "noon" or "afternoon1"
+	period string
+	bc     bool
+
+	// dayOfWeek is set for days of the week read by name, and hasDayOfWeek
+	// says whether one was
+	dayOfWeek    time.Weekday
+	hasDayOfWeek bool
+
+	// twoDigitYear is set for years of two digits, which are in the current
+	// century or an adjacent one
+	twoDigitYear bool
+
+	// location is set for zone IDs and names, and locationKind is the kind
+	// of name, one of the timeZoneName constants
+	location     *time.Location
+	locationKind int
+	offset       int
+	hasOffset    bool
+
+	// seen holds the pattern symbols which have been read
+	seen map[byte]bool
+}
+
+// ParseDateTime parses a string in the locale's format for a DateFormat,
+// TimeFormat, or DateTimeFormat constant, and returns the time it represents.
+// See ParseDateTimePattern for how lenient the parsing is.
+func (t *Translator) ParseDateTime(format int, str string, loc *time.Location) (time.Time, error) {
+	pattern, err := t.dateTimeFormatPattern(format)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.ParseDateTimePattern(pattern, str, loc)
+}
+
+// ParseDateTimePattern parses a string in a custom LDML datetime pattern, like
+// "EEE, d MMM y", and returns the time it represents. It's the reverse of
+// FormatDateTimePattern, and reads the same names of months, days, periods
+// and time zones - but leniently:
+//   - names match regardless of case, with or without their trailing period,
+//     and any width of name matches, so "oct", "Oct." and "October" are all
+//     October for "MMM"
+//   - a name can be shortened, as long as it's at least 3 letters and only one
+//     name starts with it, like "Sept"
+//   - literals match regardless of case and spacing, and literals which are
+//     only punctuation, like "/" in "d/M/y", match any punctuation or none
+//   - any time zone format matches any time zone unit
+//
+// Narrow names, like "J", are only read for narrow units, like "MMMMM", since
+// they're often ambiguous. Two-digit years are within 80 years before and 20
+// years after now. Days of the week read by name must be the date's day of the
+// week, if the string has a year and a day. Days of the week read as numbers,
+// quarters and weeks are read but don't affect the date, and week years are
+// read as calendar years.
+//
+// Fields which aren't in the string default to the start of year 0, like
+// time.Parse. Times without a time zone are in loc, or UTC if it's nil. Times
+// with an offset are in loc if it has that offset then, and in a fixed zone
+// otherwise, and times with a time zone name are in that zone, unless the
+// name means a different offset - "PST" in the summer, or any name for a time
+// without a date, which gets the offset the zone has today - in which case
+// they're in a fixed zone with that offset. Likewise, times without a date or
+// a time zone, like "3:04 PM", get the offset loc has today, rather than its
+// offset in the year 0.
+func (t *Translator) ParseDateTimePattern(pattern, str string, loc *time.Location) (time.Time, error) {
+	parsed, err := t.parseDateTimeFormat(pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.parseDateTime(parsed, str, loc)
+}
+
+// parseDateTime reads a string with a sequence of parsed pattern components.
+func (t *Translator) parseDateTime(pattern []*datetimePatternComponent, str string, loc *time.Location) (time.Time, error) {
+	p := &datetimeParser{t: t, str: str, month: 1, day: 1, seen: map[byte]bool{}}
+
+	components := joinDateTimeLiterals(pattern)
+	for i, component := range components {
+		// numeric units followed by another unit, like "HHmm", are read at
+		// their pattern length
+		fixed, nextLiteral := false, ""
+		if i+1 < len(components) {
+			if components[i+1].componentType == datetimePatternComponentLiteral {
+				nextLiteral = strings.TrimLeftFunc(components[i+1].pattern, unicode.IsSpace)
+			} else {
+				fixed = true
+			}
+		}
+
+		var err error
+		if component.componentType == datetimePatternComponentLiteral {
+			err = p.parseLiteral(component.pattern)
+		} else {
+			err = p.parseUnit(component.pattern, fixed, nextLiteral)
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if rest := strings.TrimFunc(p.str, unicode.IsSpace); rest != "" {
+		return time.Time{}, p.errorf("unexpected text")
+	}
+
+	return p.time(loc)
+}
+
+// joinDateTimeLiterals joins each run of literal components into one
+// literal, so "d, MMM" has the literal ", " rather than "," and " ".
+func joinDateTimeLiterals(pattern []*datetimePatternComponent) []*datetimePatternComponent {
+	joined := []*datetimePatternComponent{}
+	for _, component := range pattern {
+		last := len(joined) - 1
+		if component.componentType == datetimePatternComponentLiteral && last >= 0 &&
+			joined[last].componentType == datetimePatternComponentLiteral {
+			joined[last] = &datetimePatternComponent{
+				pattern:       joined[last].pattern + component.pattern,
+				componentType: datetimePatternComponentLiteral,
+			}
+			continue
+		}
+
+		joined = append(joined, component)
+	}
+
+	return joined
+}
+
+// errorf returns an error for what's left of the string
+func (p *datetimeParser) errorf(format string, args ...interface{}) error {
+	return translatorError{message: fmt.Sprintf("unable to parse datetime: "+format+": %q", append(args, p.str)...)}
+}
+
+// parseLiteral reads a literal from the pattern.
+func (p *datetimeParser) parseLiteral(literal string) error {
+	p.str = strings.TrimLeftFunc(p.str, unicode.IsSpace)
+	literal = strings.TrimFunc(literal, unicode.IsSpace)
+
+	switch {
+	case literal == "":
+		return nil
+	case hasPrefixFold(p.str, literal):
+		p.str = p.str[len(literal):]
+		return nil
+	case strings.IndexFunc(literal, isDateTimeLetterOrDigit) == -1:
+		p.str = strings.TrimLeftFunc(p.str, func(r rune) bool {
+			return !isDateTimeLetterOrDigit(r)
+		})
+		return nil
+	}
+
+	return p.errorf("expected %q", literal)
+}
+
+// parseUnit reads a single unit of the pattern.
+func (p *datetimeParser) parseUnit(pattern string, fixed bool, nextLiteral string) error {
+	symbol, length := pattern[0], len(pattern)
+	if p.seen[symbol] {
+		return translatorError{message: "repeated datetime format unit: " + pattern[0:1]}
+	}
+	p.seen[symbol] = true
+
+	p.str = strings.TrimLeftFunc(p.str, unicode.IsSpace)
+
+	numeric := length <= datetimeFormatLength2Plus
+	var err error
+	switch symbol {
+	case datetimeFormatUnitYear, datetimeFormatUnitWeekYear:
+		digits := p.readDigits(length, fixed)
+		p.year, err = p.number(digits, "year")
+		p.twoDigitYear = length <= datetimeFormatLength2Plus && len(digits) == 2
+	case datetimeFormatUnitExtendedYear, datetimeFormatUnitCyclicYear, datetimeFormatUnitRelatedYear:
+		p.year, err = p.number(p.readDigits(length, fixed), "year")
+	case datetimeFormatUnitMonth, datetimeFormatUnitMonthStandAlone:
+		if numeric {
+			p.month, err = p.number(p.readDigits(length, fixed), "month")
+		} else {
+			p.month, err = p.name(symbol, length, nextLiteral, "month")
+			p.month++
+		}
+	case datetimeFormatUnitDay:
+		p.day, err = p.number(p.readDigits(length, fixed), "day")
+	case datetimeFormatUnitDayOfYear:
+		p.dayOfYear, err = p.number(p.readDigits(length, fixed), "day of year")
+	case datetimeFormatUnitModifiedJulianDay:
+		p.modifiedJulianDay, err = p.number(p.readDigits(length, fixed), "julian day")
+	case datetimeFormatUnitWeekOfYear, datetimeFormatUnitWeekOfMonth, datetimeFormatUnitDayOfWeekInMonth:
+		_, err = p.number(p.readDigits(length, fixed), "week")
+	case datetimeFormatUnitDayOfWeekLocal, datetimeFormatUnitDayOfWeekStandAlone:
+		if numeric {
+			_, err = p.number(p.readDigits(length, fixed), "day of week")
+		} else {
+			err = p.parseDayOfWeek(symbol, length, nextLiteral)
+		}
+	case datetimeFormatUnitDayOfWeek:
+		err = p.parseDayOfWeek(symbol, length, nextLiteral)
+	case datetimeForamtUnitQuarter, datetimeFormatUnitQuarter2:
+		if numeric {
+			_, err = p.number(p.readDigits(length, fixed), "quarter")
+		} else {
+			_, err = p.name(symbol, length, nextLiteral, "quarter")
+		}
+	case datetimeFormatUnitEra:
+		era := 0
+		era, err = p.name(symbol, length, nextLiteral, "era")
+		p.bc = era == 0
+	case datetimeFormatUnitPeriod, datetimeFormatUnitPeriodNoon, datetimeFormatUnitPeriodFlexible:
+		keys := p.t.dayPeriodKeys(symbol)
+		period := 0
+		period, err = p.name(symbol, length, nextLiteral, "day period")
+		if err == nil {
+			p.period = keys[period]
+		}
+	case datetimeFormatUnitHour12, datetimeFormatUnitHour24, datetimeFormatUnitHour12From0, datetimeFormatUnitHour24From1:
+		p.hour, err = p.number(p.readDigits(length, fixed), "hour")
+	case datetimeFormatUnitMinute:
+		p.minute, err = p.number(p.readDigits(length, fixed), "minute")
+	case datetimeFormatUnitSecond:
+		p.second, err = p.number(p.readDigits(length, fixed), "second")
+	case datetimeFormatUnitFractionalSecond:
+		err = p.parseFractionalSecond(p.readDigits(length, fixed))
+	case datetimeFormatUnitMillisecondsInDay:
+		p.millisecondsInDay, err = p.number(p.readDigits(length, fixed), "milliseconds")
+	case datetimeFormatUnitTimeZone1, datetimeFormatUnitTimeZone2, datetimeFormatUnitTimeZoneID,
+		datetimeFormatUnitTimeZoneGMT, datetimeFormatUnitTimeZoneISOZ, datetimeFormatUnitTimeZoneISO,
+		datetimeFormatUnitTimeZoneRFC:
+		err = p.parseTimeZone()
+	default:
+		return translatorError{message: "unknown datetime format unit: " + pattern[0:1]}
+	}
+
+	return err
+}
+
+// parseDayOfWeek reads the name of a day of the week.
+func (p *datetimeParser) parseDayOfWeek(symbol byte, length int, nextLiteral string) error {
+	dayOfWeek, err := p.name(symbol, length, nextLiteral, "day of week")
+	p.dayOfWeek, p.hasDayOfWeek = time.Weekday(dayOfWeek), err == nil
+	return err
+}
+
+// readDigits reads a run of digits - at most the pattern length of them if
+// fixed is set.
+func (p *datetimeParser) readDigits(length int, fixed bool) string {
+	end := strings.IndexFunc(p.str, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end == -1 {
+		end = len(p.str)
+	}
+	if fixed && end > length {
+		end = length
+	}
+
+	digits := p.str[:end]
+	p.str = p.str[end:]
+	return digits
+}
+
+// number converts digits read from the string to a number.
+func (p *datetimeParser) number(digits, what string) (int, error) {
+	if digits == "" || len(digits) > 9 {
+		return 0, p.errorf("expected %s", what)
+	}
+
+	number := 0
+	for _, digit := range digits {
+		number = number*10 + int(digit-'0')
+	}
+
+	return number, nil
+}
+
+// parseFractionalSecond reads a fraction of a second, which is exact to the
+// nanosecond.
+func (p *datetimeParser) parseFractionalSecond(digits string) error {
+	if digits == "" {
+		return p.errorf("expected fractional second")
+	}
+
+	digits = (digits + "000000000")[:9]
+	nanosecond, err := p.number(digits, "fractional second")
+	p.nanosecond = nanosecond
+	return err
+}
+
+// twoDigitYear returns the year ending in two digits which is within 80 years
+// before and 20 years after the current year.
+func twoDigitYear(year, currentYear int) int {
+	year += currentYear / 100 * 100
+	switch {
+	case year > currentYear+20:
+		year -= 100
+	case year <= currentYear-80:
+		year += 100
+	}
+
+	return year
+}
+
+// name reads one of the locale's names for a unit, and returns its value.
+// A period after the name is skipped, unless the pattern has one there.
+func (p *datetimeParser) name(symbol byte, length int, nextLiteral, what string) (int, error) {
+	value, end, ok := matchDateTimeName(p.str, p.t.dateTimeNames(symbol, length))
+	if !ok {
+		return 0, p.errorf("expected %s", what)
+	}
+
+	p.str = p.str[end:]
+	if strings.HasPrefix(p.str, ".") && !strings.HasPrefix(nextLiteral, ".") {
+		p.str = p.str[1:]
+	}
+
+	return value, nil
+}
+
+// dateTimeNames returns the locale's names for a unit, in every width but
+// narrow, unless the unit is narrow. Values are the index of the month, the
+// day of the week from Sunday, the era from BC, the quarter, or the day
+// period key from dayPeriodKeys.
+func (t *Translator) dateTimeNames(symbol byte, length int) []datetimeName {
+	lengths := []int{datetimeFormatLengthAbbreviated, datetimeFormatLengthWide, datetimeFormatLengthShort}
+	if length == datetimeFormatLengthNarrow {
+		lengths = append(lengths, datetimeFormatLengthNarrow)
+	}
+
+	dates := []time.Time{}
+	var formats []func(time.Time, int) (string, error)
+
+	switch symbol {
+	case datetimeFormatUnitMonth, datetimeFormatUnitMonthStandAlone:
+		for month := time.January; month <= time.December; month++ {
+			dates = append(dates, time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+		}
+		formats = append(formats, t.formatDateTimeComponentMonth, t.formatDateTimeComponentMonthStandAlone)
+	case datetimeFormatUnitDayOfWeek, datetimeFormatUnitDayOfWeekLocal, datetimeFormatUnitDayOfWeekStandAlone:
+		// January 1st 2006 was a Sunday
+		for day := 1; day <= 7; day++ {
+			dates = append(dates, time.Date(2006, time.January, day, 0, 0, 0, 0, time.UTC))
+		}
+		formats = append(formats, t.formatDateTimeComponentDayOfWeek, t.formatDateTimeComponentDayOfWeekStandAlone)
+	case datetimeFormatUnitEra:
+		for year := 0; year <= 1; year++ {
+			dates = append(dates, time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+		}
+		formats = append(formats, t.formatDateTimeComponentEra)
+	case datetimeForamtUnitQuarter, datetimeFormatUnitQuarter2:
+		for month := time.January; month <= time.December; month += 3 {
+			dates = append(dates, time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+		}
+		for _, standAlone := range []bool{false, true} {
+			standAlone := standAlone
+			formats = append(formats, func(datetime time.Time, length int) (string, error) {
+				return t.formatDateTimeComponentQuarter(datetime, length, standAlone)
+			})
+		}
+	case datetimeFormatUnitPeriod, datetimeFormatUnitPeriodNoon, datetimeFormatUnitPeriodFlexible:
+		return t.dayPeriodNames(symbol, lengths)
+	}
+
+	names := []datetimeName{}
+	for _, format := range formats {
+		for _, length := range lengths {
+			for value, date := range dates {
+				if name, err := format(date, length); err == nil && name != "" {
+					names = append(names, datetimeName{value: value, name: name})
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// dayPeriodKeys returns the day periods a period unit can be - AM and PM, and
+// noon and midnight for "b", or any of the locale's day periods for "B"
+func (t *Translator) dayPeriodKeys(symbol byte) []string {
+	keys := []string{"am", "pm"}
+
+	switch symbol {
+	case datetimeFormatUnitPeriodNoon:
+		keys = append(keys, "midnight", "noon")
+	case datetimeFormatUnitPeriodFlexible:
+		periods := []string{}
+		for period := range t.rules.DateTime.DayPeriodRules {
+			periods = append(periods, period)
+		}
+		sort.Strings(periods)
+		keys = append(keys, periods...)
+	}
+
+	return keys
+}
+
+// dayPeriodNames returns the locale's names for the day periods of a period
+// unit.
+func (t *Translator) dayPeriodNames(symbol byte, lengths []int) []datetimeName {
+	names := []datetimeName{}
+	for _, length := range lengths {
+		for value, key := range t.dayPeriodKeys(symbol) {
+			name := ""
+			switch key {
+			case "am", "pm":
+				hour := 0
+				if key == "pm" {
+					hour = 12
+				}
+				name, _ = t.formatDateTimeComponentPeriod(time.Date(2000, time.January, 1, hour, 0, 0, 0, time.UTC), length)
+			default:
+				name = t.dayPeriodName(key, length)
+			}
+
+			if name != "" {
+				names = append(names, datetimeName{value: value, name: name})
+			}
+		}
+	}
+
+	return names
+}
+
+// matchDateTimeName returns the value of the name at the start of a string,
+// and the length of the name in the string. The longest name matches, with or
+// without a trailing period. Failing that, a word of at least 3 letters
+// matches the one value with a name starting with it.
+func matchDateTimeName(str string, names []datetimeName) (value, length int, ok bool) {
+	if value, length, ok = matchDateTimeNameExact(str, names); ok {
+		return value, length, ok
+	}
+
+	word := str[:len(str)-len(strings.TrimLeftFunc(str, unicode.IsLetter))]
+	if utf8.RuneCountInString(word) < 3 {
+		return 0, 0, false
+	}
+
+	for _, name := range names {
+		if !hasPrefixFold(name.name, word) {
+			continue
+		}
+		if ok && value != name.value {
+			return 0, 0, false
+		}
+		value, length, ok = name.value, len(word), true
+	}
+
+	return value, length, ok
+}
+
+// matchDateTimeNameExact returns the value of the longest name at the start
+// of a string, with or without a trailing period. Names ending in a letter
+// only match whole words. Of names with the same length, the first matches.
+func matchDateTimeNameExact(str string, names []datetimeName) (value, length int, ok bool) {
+	for _, name := range names {
+		candidates := []string{name.name}
+		if trimmed := strings.TrimSuffix(name.name, "."); trimmed != name.name && trimmed != "" {
+			candidates = append(candidates, trimmed)
+		}
+
+		for _, candidate := range candidates {
+			if len(candidate) <= length || !hasPrefixFold(str, candidate) {
+				continue
+			}
+
+			last, _ := utf8.DecodeLastRuneInString(candidate)
+			next, _ := utf8.DecodeRuneInString(str[len(candidate):])
+			if unicode.IsLetter(last) && unicode.IsLetter(next) {
+				continue
+			}
+
+			value, length, ok = name.value, len(candidate), true
+		}
+	}
+
+	return value, length, ok
+}
+
+// hasPrefixFold returns whether a string starts with a prefix, regardless of
+// case
+func hasPrefixFold(str, prefix string) bool {
+	return len(str) >= len(prefix) && strings.EqualFold(str[:len(prefix)], prefix)
+}
+
+// isDateTimeLetterOrDigit returns whether a rune is a letter or a digit
+func isDateTimeLetterOrDigit(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// parseTimeZone reads a time zone in any of the time zone formats - "Z", an
+// ISO 8601 or RFC 822 offset, the localized GMT format, a zone ID, or one of
+// the locale's zone names.
+func (p *datetimeParser) parseTimeZone() error {
+	if strings.HasPrefix(p.str, "Z") {
+		next, _ := utf8.DecodeRuneInString(p.str[1:])
+		if !unicode.IsLetter(next) {
+			p.str = p.str[1:]
+			p.offset, p.hasOffset = 0, true
+			return nil
+		}
+	}
+
+	if offset, end, ok := parseTimeZoneOffset(p.str); ok {
+		p.str = p.str[end:]
+		p.offset, p.hasOffset = offset, true
+		return nil
+	}
+
+	gmtFormat := p.t.rules.DateTime.TimeZoneNames.GMTFormat
+	if gmtFormat == "" {
+		gmtFormat = "GMT{0}"
+	}
+	gmtPrefix, gmtSuffix := gmtFormat, ""
+	if pos := strings.Index(gmtFormat, "{0}"); pos != -1 {
+		gmtPrefix, gmtSuffix = gmtFormat[:pos], gmtFormat[pos+3:]
+	}
+
+	gmtZeroLength := 0
+	for _, prefix := range []string{p.t.rules.DateTime.TimeZoneNames.GMTZeroFormat, gmtPrefix, "GMT", "UTC"} {
+		if prefix == "" || !hasPrefixFold(p.str, prefix) {
+			continue
+		}
+
+		if offset, end, ok := parseTimeZoneOffset(p.str[len(prefix):]); ok {
+			p.str = p.str[len(prefix)+end:]
+			if gmtSuffix != "" && hasPrefixFold(p.str, gmtSuffix) {
+				p.str = p.str[len(gmtSuffix):]
+			}
+			p.offset, p.hasOffset = offset, true
+			return nil
+		}
+
+		if len(prefix) > gmtZeroLength {
+			gmtZeroLength = len(prefix)
+		}
+	}
+
+	token := p.str
+	if end := strings.IndexFunc(token, unicode.IsSpace); end != -1 {
+		token = token[:end]
+	}
+	if strings.Contains(token, "/") {
+		id := token
+		if alias, ok := timeZoneAliases[id]; ok {
+			id = alias
+		}
+		if location, err := time.LoadLocation(id); err == nil {
+			p.str = p.str[len(token):]
+			p.location, p.locationKind = location, timeZoneNameGeneric
+			return nil
+		}
+	}
+
+	candidates, names := p.t.timeZoneNameCandidates()
+	if value, end, ok := matchDateTimeNameExact(p.str, names); ok && end > gmtZeroLength {
+		if location, err := time.LoadLocation(candidates[value].id); err == nil {
+			p.str = p.str[end:]
+			p.location, p.locationKind = location, candidates[value].kind
+			return nil
+		}
+	}
+
+	if gmtZeroLength > 0 {
+		p.str = p.str[gmtZeroLength:]
+		p.offset, p.hasOffset = 0, true
+		return nil
+	}
+
+	return p.errorf("expected time zone")
+}
+
+// parseTimeZoneOffset reads a signed offset from the start of a string, like
+// "+2", "-0800", "+05:30" or "-08:00:00", and returns the offset in seconds
+// and the length of the offset in the string.
+func parseTimeZoneOffset(str string) (offset, length int, ok bool) {
+	sign, size := 1, 0
+	switch r, n := utf8.DecodeRuneInString(str); r {
+	case '+':
+		size = n
+	case '-', '\u2212':
+		sign, size = -1, n
+	default:
+		return 0, 0, false
+	}
+
+	digits := func(pos, min, max int) (int, int) {
+		number, end := 0, pos
+		for end < len(str) && end-pos < max && str[end] >= '0' && str[end] <= '9' {
+			number = number*10 + int(str[end]-'0')
+			end++
+		}
+		if end-pos < min {
+			return 0, pos
+		}
+		return number, end
+	}
+
+	hours, end := digits(size, 1, 2)
+	if end == size || hours > 23 {
+		return 0, 0, false
+	}
+	offset, length = hours*3600, end
+
+	// minutes and seconds either both have a separator, or neither does
+	separator := ""
+	if end < len(str) && (str[end] == ':' || str[end] == '.') {
+		separator = str[end : end+1]
+	} else if end-size != 2 {
+		return sign * offset, length, true
+	}
+
+	for _, unit := range []int{60, 1} {
+		if !strings.HasPrefix(str[length:], separator) {
+			break
+		}
+		number, end := digits(length+len(separator), 2, 2)
+		if end == length+len(separator) || number > 59 {
+			break
+		}
+		offset, length = offset+number*unit, end
+	}
+
+	return sign * offset, length, true
+}
+
+// The kinds of time zone names. Standard and daylight names, like "Pacific
+// Standard Time", are for one of a zone's offsets, and generic names, like
+// "Pacific Time" or "Los Angeles Time", are for whichever offset the zone has
+// at the time.
+const (
+	timeZoneNameGeneric = iota
+	timeZoneNameStandard
+	timeZoneNameDaylight
+)
+
+// timeZoneCandidate is a time zone a name can be parsed as, and the kind of
+// name it is
+type timeZoneCandidate struct {
+	id   string
+	kind int
+}
+
+// timeZoneNameCandidates returns the time zones the locale has names for, and
+// their names - their specific, generic and location names. The value of each
+// name is the index of its candidate. Names shared by a metazone's zones, like
+// "Pacific Time", match the zone whose rules define the metazone.
+func (t *Translator) timeZoneNameCandidates() (candidates []timeZoneCandidate, names []datetimeName) {
+	ids := []string{}
+	for _, id := range metazoneGoldenZones {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	seen := map[string]bool{unknownTimeZone: true}
+	for _, id := range ids {
+		seen[id] = true
+	}
+
+	others := []string{}
+	for id := range metazones {
+		if !seen[id] {
+			seen[id] = true
+			others = append(others, id)
+		}
+	}
+	for id := range t.rules.DateTime.TimeZoneNames.Zones {
+		if !seen[id] {
+			seen[id] = true
+			others = append(others, id)
+		}
+	}
+	sort.Strings(others)
+	ids = append(ids, others...)
+
+	add := func(id, name string, kind int) {
+		if name != "" {
+			names = append(names, datetimeName{value: len(candidates), name: name})
+			candidates = append(candidates, timeZoneCandidate{id: id, kind: kind})
+		}
+	}
+
+	for _, id := range ids {
+		zoneNames := t.timeZoneNames(id)
+		add(id, zoneNames.Long.Generic, timeZoneNameGeneric)
+		add(id, zoneNames.Long.Standard, timeZoneNameStandard)
+		add(id, zoneNames.Long.Daylight, timeZoneNameDaylight)
+		add(id, zoneNames.Short.Generic, timeZoneNameGeneric)
+		add(id, zoneNames.Short.Standard, timeZoneNameStandard)
+		add(id, zoneNames.Short.Daylight, timeZoneNameDaylight)
+
+		if location, ok := t.timeZoneLocation(id); ok {
+			add(id, location, timeZoneNameGeneric)
+		}
+	}
+
+	return candidates, names
+}
+
+// time returns the time the parsed values represent.
+func (p *datetimeParser) time(loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	// two-digit years with an era are years of the era
+	year, month, day := p.year, p.month, p.day
+	switch {
+	case p.seen[datetimeFormatUnitEra]:
+		if p.bc {
+			year = 1 - year
+		}
+	case p.twoDigitYear:
+		year = twoDigitYear(year, time.Now().Year())
+	}
+
+	hour, err := p.hour24()
+	if err != nil {
+		return time.Time{}, err
+	}
+	minute, second, nanosecond := p.minute, p.second, p.nanosecond
+	if minute > 59 || second > 59 {
+		return time.Time{}, translatorError{message: "invalid time"}
+	}
+
+	// milliseconds in the day are only used for times without hours, minutes
+	// or seconds
+	if p.seen[datetimeFormatUnitMillisecondsInDay] && !p.seenAny("hHKkmsS") {
+		milliseconds := p.millisecondsInDay
+		hour, minute, second = milliseconds/3600000, milliseconds/60000%60, milliseconds/1000%60
+		nanosecond = milliseconds % 1000 * 1000000
+	}
+
+	// days of the year and julian days are only used for dates without months
+	// or days
+	checkDate := true
+	switch {
+	case p.seen[datetimeFormatUnitModifiedJulianDay] && !p.seenAny("yYuUrMLdD"):
+		date := time.Unix(int64(p.modifiedJulianDay-40587)*86400, 0).UTC()
+		year, month, day = date.Year(), int(date.Month()), date.Day()
+	case p.seen[datetimeFormatUnitDayOfYear] && !p.seenAny("MLd"):
+		if p.dayOfYear < 1 || p.dayOfYear > time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return time.Time{}, translatorError{message: "invalid date"}
+		}
+		month, day, checkDate = 1, p.dayOfYear, false
+	}
+
+	location := loc
+	if p.location != nil {
+		location = p.location
+	}
+
+	datetime := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, location)
+	if checkDate && (month < 1 || month > 12 || datetime.Month() != time.Month(month) || datetime.Day() != day) {
+		return time.Time{}, translatorError{message: "invalid date"}
+	}
+
+	// a day of the week can only be checked against a whole date
+	wholeDate := p.seen[datetimeFormatUnitModifiedJulianDay] || (p.seenAny("yYuUr") && p.seenAny("dD"))
+	if p.hasDayOfWeek && wholeDate && datetime.Weekday() != p.dayOfWeek {
+		return time.Time{}, translatorError{message: "day of week doesn't match the date"}
+	}
+
+	switch {
+	case p.location != nil:
+		if zone, ok := p.zoneNameLocation(datetime); ok {
+			datetime = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, zone)
+		}
+	case p.hasOffset:
+		if _, offset := datetime.Zone(); offset != p.offset {
+			datetime = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.FixedZone("", p.offset))
+		}
+	case !p.seenAny(datetimeDateSymbols):
+		name, offset := onCurrentDate(datetime).Zone()
+		if _, current := datetime.Zone(); current != offset {
+			datetime = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.FixedZone(name, offset))
+		}
+	}
+
+	return datetime, nil
+}
+
+// zoneNameLocation returns a fixed zone for a time in a parsed time zone, when
+// the zone's own offset at the time isn't the one its name means. Standard and
+// daylight names get the zone's standard or daylight offset, so "PST" is
+// -0800 even in the summer, and times without a date use the zone's offset on
+// the current date, rather than its offset in the year 0.
+func (p *datetimeParser) zoneNameLocation(datetime time.Time) (*time.Location, bool) {
+	ref := datetime
+	if !p.seenAny(datetimeDateSymbols) {
+		ref = onCurrentDate(datetime)
+	}
+
+	name, offset := ref.Zone()
+	daylight := p.locationKind == timeZoneNameDaylight
+	if p.locationKind != timeZoneNameGeneric && ref.IsDST() != daylight {
+		// zones have their standard offset in one of January and July, and
+		// their daylight offset in the other, if they have one
+		for _, month := range []time.Month{time.January, time.July} {
+			other := time.Date(ref.Year(), month, 1, 12, 0, 0, 0, p.location)
+			if other.IsDST() == daylight {
+				name, offset = other.Zone()
+				break
+			}
+		}
+	}
+
+	if _, current := datetime.Zone(); current == offset {
+		return nil, false
+	}
+	return time.FixedZone(name, offset), true
+}
+
+// datetimeDateSymbols are the pattern symbols which are part of a date
+const datetimeDateSymbols = "GyYuUrMLdDg"
+
+// onCurrentDate returns the same time of day on the current date, in the
+// time's location.
+func onCurrentDate(datetime time.Time) time.Time {
+	now := time.Now().In(datetime.Location())
+	return time.Date(now.Year(), now.Month(), now.Day(), datetime.Hour(), datetime.Minute(), datetime.Second(), datetime.Nanosecond(), datetime.Location())
+}
+
+// seenAny returns whether any of the pattern symbols have been read
+func (p *datetimeParser) seenAny(symbols string) bool {
+	for i := 0; i < len(symbols); i++ {
+		if p.seen[symbols[i]] {
+			return true
+		}
+	}
+	return false
+}
+
+// hour24 returns the parsed hour on a 24-hour clock. Hours on a 12-hour clock
+// are in the half of the day their period is in.
+func (p *datetimeParser) hour24() (int, error) {
+	hour := p.hour
+
+	switch {
+	case p.seen[datetimeFormatUnitHour12], p.seen[datetimeFormatUnitHour12From0]:
+		if hour > 12 {
+			return 0, translatorError{message: "invalid hour"}
+		}
+		if p.period != "" {
+			hour = p.t.dayPeriodHour(p.period, hour%12, p.minute)
+		}
+	case p.seen[datetimeFormatUnitHour24From1]:
+		if hour > 24 {
+			return 0, translatorError{message: "invalid hour"}
+		}
+		hour %= 24
+	case hour > 23:
+		return 0, translatorError{message: "invalid hour"}
+	}
+
+	return hour, nil
+}
+
+// dayPeriodHour returns the hour on a 24-hour clock for an hour from 0 to 11
+// in a day period - the hour itself, or 12 hours later, whichever is in the
+// period.
+func (t *Translator) dayPeriodHour(period string, hour, minute int) int {
+	switch period {
+	case "am":
+		return hour
+	case "pm":
+		return hour + 12
+	}
+
+	rule, ok := t.rules.DateTime.DayPeriodRules[period]
+	if !ok {
+		if period == "noon" {
+			return 12
+		}
+		return hour
+	}
+
+	for _, candidate := range []int{hour, hour + 12} {
+		minutes := candidate*60 + minute
+		if dash := strings.Index(rule, "-"); dash != -1 {
+			if inDayPeriod(minutes, rule[:dash], rule[dash+1:]) {
+				return candidate
+			}
+		} else if minutes == dayPeriodMinutes(rule) {
+			return candidate
+		}
+	}
+
+	return hour
+}
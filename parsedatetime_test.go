@@ -0,0 +1,319 @@
+package i18n
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseDateTimeRoundTrip(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	c.Assert(err, IsNil)
+
+	datetimes := []time.Time{
+		time.Date(2026, time.October, 17, 15, 4, 5, 0, time.UTC),
+		time.Date(2026, time.July, 2, 0, 30, 0, 0, losAngeles),
+		time.Date(2026, time.March, 1, 12, 0, 59, 0, losAngeles),
+	}
+
+	for _, locale := range []string{"en", "en-GB", "de", "fr"} {
+		translator, _ := f.GetTranslator(locale)
+		c.Assert(translator, NotNil)
+
+		for format := DateFormatFull; format <= DateTimeFormatShort; format++ {
+			for _, datetime := range datetimes {
+				str, err := translator.FormatDateTime(format, datetime)
+				c.Assert(err, IsNil)
+
+				comment := Commentf("%s %d %q", locale, format, str)
+				parsed, err := translator.ParseDateTime(format, str, datetime.Location())
+				c.Check(err, IsNil, comment)
+
+				expected := datetime
+				if format == TimeFormatShort || format == DateTimeFormatShort {
+					expected = expected.Add(-time.Duration(datetime.Second()) * time.Second)
+				}
+
+				switch {
+				case format <= DateFormatShort:
+					c.Check(parsed, Equals, time.Date(expected.Year(), expected.Month(), expected.Day(), 0, 0, 0, 0, expected.Location()), comment)
+				case format <= TimeFormatShort:
+					// times in year 0 can have a different offset, so only
+					// the clocks are compared
+					hour, minute, second := parsed.Clock()
+					c.Check([]int{hour, minute, second}, DeepEquals, []int{expected.Hour(), expected.Minute(), expected.Second()}, comment)
+				default:
+					c.Check(parsed.Equal(expected), Equals, true, comment)
+				}
+			}
+		}
+	}
+}
+
+func (s *MySuite) TestParseDateTimePattern(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	translators := map[string]*Translator{}
+	for _, locale := range []string{"en", "en-GB", "de", "fr", "ru"} {
+		translators[locale], _ = f.GetTranslator(locale)
+		c.Assert(translators[locale], NotNil)
+	}
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	clock := func(hour, minute, second int) time.Time {
+		return time.Date(0, time.January, 1, hour, minute, second, 0, time.UTC)
+	}
+
+	tests := []struct {
+		locale   string
+		pattern  string
+		str      string
+		expected time.Time
+	}{
+		// numbers
+		{"en-GB", "dd/MM/y", "17/10/2026", date(2026, time.October, 17)},
+		{"en-GB", "dd/MM/y", "17-10-2026", date(2026, time.October, 17)},
+		{"en", "M/d/yy", "10/17/26", date(2026, time.October, 17)},
+		{"en", "M/d/yy", "10/17/99", date(1999, time.October, 17)},
+		{"en", "M/d/yy", "10/17/2026", date(2026, time.October, 17)},
+		{"en", "yyyyMMdd", "20261017", date(2026, time.October, 17)},
+		{"en", "y-D", "2026-290", date(2026, time.October, 17)},
+		{"en", "g", "61330", date(2026, time.October, 17)},
+		{"de", "d.M.y", "17.10.2026", date(2026, time.October, 17)},
+
+		// names
+		{"en", "MMM d, y", "Oct 17, 2026", date(2026, time.October, 17)},
+		{"en", "MMM d, y", "oct. 17, 2026", date(2026, time.October, 17)},
+		{"en", "MMM d, y", "OCTOBER 17, 2026", date(2026, time.October, 17)},
+		{"en", "MMM d, y", "Sept 17, 2026", date(2026, time.September, 17)},
+		{"en", "MMMM d, y", "May 17, 2026", date(2026, time.May, 17)},
+		{"en", "EEEE, MMMM d, y", "saturday, october 17, 2026", date(2026, time.October, 17)},
+		{"en", "EEE, MMM d, y", "Sat., Oct. 17 2026", date(2026, time.October, 17)},
+		{"en", "EEE, MMM d", "Sun, Oct 17", date(0, time.October, 17)},
+		{"en", "EEEE h:mm a", "Monday 3:04 PM", clock(15, 4, 0)},
+		{"en", "MMMMM d, y", "O 17, 2026", date(2026, time.October, 17)},
+		{"en", "d MMM y G", "17 Oct 44 BC", date(-43, time.October, 17)},
+		{"en", "QQQ MMM y", "Q4 Oct 2026", date(2026, time.October, 1)},
+		{"fr", "d MMM y", "17 oct. 2026", date(2026, time.October, 17)},
+		{"fr", "d MMM y", "17 oct 2026", date(2026, time.October, 17)},
+		{"fr", "EEEE d MMMM y", "samedi 17 octobre 2026", date(2026, time.October, 17)},
+		{"de", "d. MMM y", "17. Okt. 2026", date(2026, time.October, 17)},
+		{"de", "d. MMMM y", "1. März 2026", date(2026, time.March, 1)},
+		{"ru", "LLLL y", "январь 2026", date(2026, time.January, 1)},
+		{"ru", "d MMMM y", "2 января 2026", date(2026, time.January, 2)},
+
+		// times
+		{"en", "h:mm a", "3:04 PM", clock(15, 4, 0)},
+		{"en", "h:mm a", "3:04 pm", clock(15, 4, 0)},
+		{"en", "h:mm a", "12:30 AM", clock(0, 30, 0)},
+		{"en", "h:mm a", "12:30 PM", clock(12, 30, 0)},
+		{"en", "h:mm aaaaa", "3:04 p", clock(15, 4, 0)},
+		{"en", "K:mm a", "0:30 PM", clock(12, 30, 0)},
+		{"en", "HHmmss", "150405", clock(15, 4, 5)},
+		{"en", "k:mm", "24:00", clock(0, 0, 0)},
+		{"en", "H:mm:ss.SSS", "15:04:05.123", time.Date(0, time.January, 1, 15, 4, 5, 123000000, time.UTC)},
+		{"en", "A", "54245000", clock(15, 4, 5)},
+		{"en", "h:mm B", "3:04 in the afternoon", clock(15, 4, 0)},
+		{"en", "h:mm B", "9:30 in the morning", clock(9, 30, 0)},
+		{"en", "h:mm B", "11:00 at night", clock(23, 0, 0)},
+		{"en", "h:mm B", "2:00 at night", clock(2, 0, 0)},
+		{"en", "h b", "12 noon", clock(12, 0, 0)},
+		{"en", "h b", "12 midnight", clock(0, 0, 0)},
+		{"de", "h:mm B", "3:04 nachm.", clock(15, 4, 0)},
+	}
+
+	for _, test := range tests {
+		comment := Commentf("%s %s %q", test.locale, test.pattern, test.str)
+		parsed, err := translators[test.locale].ParseDateTimePattern(test.pattern, test.str, nil)
+		c.Check(err, IsNil, comment)
+		c.Check(parsed, Equals, test.expected, comment)
+	}
+
+	for _, test := range []struct {
+		pattern string
+		str     string
+	}{
+		{"M/d/y", ""},
+		{"M/d/y", "13/1/2026"},
+		{"M/d/y", "2/30/2026"},
+		{"M/d/y", "10/17/2026 extra"},
+		{"MMM d, y", "Ma 17, 2026"},
+		{"MMM d, y", "Ju 17, 2026"},
+		{"MMM d, y", "Octobers 17, 2026"},
+		{"MMMM d, y", "O 17, 2026"},
+		{"H:mm", "24:00"},
+		{"h:mm a", "13:00 PM"},
+		{"h:mm a", "1:60 PM"},
+		{"h:mm a", "1:00 XM"},
+		{"h 'o''clock'", "1 hour"},
+		{"y-D", "2026-366"},
+		{"d d", "1 1"},
+		{"z", "Nowhere Time"},
+		{"EEEE, MMMM d, y", "Sunday, October 17, 2026"},
+		{"EEE y-D", "Mon 2026-290"},
+		{"EEE d.M.y EEEE", "Sat 17.10.2026 Saturday"},
+	} {
+		_, err := translators["en"].ParseDateTimePattern(test.pattern, test.str, nil)
+		c.Check(err, NotNil, Commentf("%s %q", test.pattern, test.str))
+	}
+
+	_, err := translators["en"].ParseDateTime(-1, "", nil)
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestParseDateTimeTimeZone(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	translator, _ := f.GetTranslator("en")
+	c.Assert(translator, NotNil)
+
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	c.Assert(err, IsNil)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	c.Assert(err, IsNil)
+
+	summer := time.Date(2026, time.July, 2, 15, 4, 0, 0, losAngeles)
+	winter := time.Date(2026, time.January, 2, 15, 4, 0, 0, losAngeles)
+
+	tests := []struct {
+		pattern  string
+		str      string
+		location string
+		expected time.Time
+	}{
+		{"y-MM-dd HH:mm z", "2026-07-02 15:04 Pacific Daylight Time", "America/Los_Angeles", summer},
+		{"y-MM-dd HH:mm z", "2026-07-02 15:04 PDT", "America/Los_Angeles", summer},
+		{"y-MM-dd HH:mm v", "2026-01-02 15:04 Pacific Time", "America/Los_Angeles", winter},
+		{"y-MM-dd HH:mm VVVV", "2026-01-02 15:04 Los Angeles Time", "America/Los_Angeles", winter},
+		{"y-MM-dd HH:mm VV", "2026-01-02 15:04 America/Los_Angeles", "America/Los_Angeles", winter},
+		{"y-MM-dd HH:mm VV", "2026-01-02 15:04 Asia/Calcutta", "Asia/Kolkata", time.Date(2026, time.January, 2, 15, 4, 0, 0, kolkata)},
+		{"y-MM-dd HH:mm z", "2026-01-02 15:04 Coordinated Universal Time", "Etc/UTC", time.Date(2026, time.January, 2, 15, 4, 0, 0, time.UTC)},
+		{"y-MM-dd HH:mm O", "2026-01-02 15:04 GMT-8", "", winter},
+		{"y-MM-dd HH:mm OOOO", "2026-01-02 15:04 GMT-08:00", "", winter},
+		{"y-MM-dd HH:mm O", "2026-01-02 15:04 GMT+5:30", "", time.Date(2026, time.January, 2, 15, 4, 0, 0, kolkata)},
+		{"y-MM-dd HH:mm O", "2026-01-02 23:04 GMT", "", winter},
+		{"y-MM-dd HH:mm XXX", "2026-01-02 23:04 Z", "", winter},
+		{"y-MM-dd HH:mm Z", "2026-01-02 15:04 -0800", "", winter},
+		{"y-MM-dd HH:mm xxx", "2026-01-02 15:04 −08:00", "", winter},
+		{"y-MM-dd'T'HH:mmX", "2026-01-02T15:04-08", "", winter},
+	}
+
+	for _, test := range tests {
+		comment := Commentf("%s %q", test.pattern, test.str)
+		parsed, err := translator.ParseDateTimePattern(test.pattern, test.str, nil)
+		c.Check(err, IsNil, comment)
+		c.Check(parsed.Equal(test.expected), Equals, true, comment)
+		if test.location != "" {
+			c.Check(parsed.Location().String(), Equals, test.location, comment)
+		}
+	}
+
+	// offsets are in the given location when it has that offset
+	parsed, err := translator.ParseDateTimePattern("y-MM-dd HH:mm Z", "2026-01-02 15:04 -0800", losAngeles)
+	c.Check(err, IsNil)
+	c.Check(parsed, Equals, winter)
+
+	parsed, err = translator.ParseDateTimePattern("y-MM-dd HH:mm Z", "2026-01-02 15:04 -0700", losAngeles)
+	c.Check(err, IsNil)
+	_, offset := parsed.Zone()
+	c.Check(offset, Equals, -7*3600)
+
+	// times without a time zone are in the given location
+	parsed, err = translator.ParseDateTimePattern("y-MM-dd HH:mm", "2026-07-02 15:04", losAngeles)
+	c.Check(err, IsNil)
+	c.Check(parsed, Equals, summer)
+
+	// standard and daylight names keep their offset out of season
+	parsed, err = translator.ParseDateTimePattern("MMMM d, y 'at' h:mm:ss a z", "October 17, 2026 at 1:05:09 PM PST", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed.Format("2006-01-02 15:04:05 -0700 MST"), Equals, "2026-10-17 13:05:09 -0800 PST")
+
+	parsed, err = translator.ParseDateTimePattern("y-MM-dd HH:mm z", "2026-01-02 15:04 Pacific Daylight Time", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed.Format("2006-01-02 15:04 -0700"), Equals, "2026-01-02 15:04 -0700")
+
+	// in season, they're in the zone's location
+	parsed, err = translator.ParseDateTimePattern("MMMM d, y 'at' h:mm:ss a z", "October 17, 2026 at 1:05:09 PM PDT", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed.Location().String(), Equals, "America/Los_Angeles")
+	c.Check(parsed.Format("15:04:05 -0700"), Equals, "13:05:09 -0700")
+
+	// times without a date don't get the zone's offset from the year 0
+	parsed, err = translator.ParseDateTimePattern("h:mm:ss a z", "1:05:09 PM EDT", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed.Format("15:04:05 -0700"), Equals, "13:05:09 -0400")
+
+	parsed, err = translator.ParseDateTimePattern("h:mm:ss a z", "1:05:09 PM EST", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed.Format("15:04:05 -0700"), Equals, "13:05:09 -0500")
+
+	// and neither do times without a date or a time zone
+	parsed, err = translator.ParseDateTimePattern("h:mm a", "3:04 PM", losAngeles)
+	c.Check(err, IsNil)
+	_, offset = time.Now().In(losAngeles).Zone()
+	_, parsedOffset := parsed.Zone()
+	c.Check(parsedOffset, Equals, offset)
+	c.Check(parsed.Format("15:04"), Equals, "15:04")
+
+	parsed, err = translator.ParseDateTimePattern("h:mm a", "3:04 PM", nil)
+	c.Check(err, IsNil)
+	c.Check(parsed, Equals, time.Date(0, time.January, 1, 15, 4, 0, 0, time.UTC))
+}
+
+func (s *MySuite) TestParseTimeZoneOffset(c *C) {
+	tests := []struct {
+		str    string
+		offset int
+		length int
+	}{
+		{"+2", 7200, 2},
+		{"-8 ", -28800, 2},
+		{"+0530", 19800, 5},
+		{"+05:30", 19800, 6},
+		{"-08:00:30", -28830, 9},
+		{"+053", 18000, 3},
+		{"+5:3", 18000, 2},
+	}
+
+	for _, test := range tests {
+		offset, length, ok := parseTimeZoneOffset(test.str)
+		c.Check(ok, Equals, true, Commentf(test.str))
+		c.Check(offset, Equals, test.offset, Commentf(test.str))
+		c.Check(length, Equals, test.length, Commentf(test.str))
+	}
+
+	for _, str := range []string{"", "+", "0800", "+24", "GMT"} {
+		_, _, ok := parseTimeZoneOffset(str)
+		c.Check(ok, Equals, false, Commentf(str))
+	}
+}
+
+func (s *MySuite) TestTwoDigitYear(c *C) {
+	c.Check(twoDigitYear(26, 2026), Equals, 2026)
+	c.Check(twoDigitYear(46, 2026), Equals, 2046)
+	c.Check(twoDigitYear(47, 2026), Equals, 1947)
+	c.Check(twoDigitYear(99, 2026), Equals, 1999)
+	c.Check(twoDigitYear(5, 2090), Equals, 2105)
+}
@@ -0,0 +1,447 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// pluralOperands contains the CLDR plural operands for a number, as described
+// here: http://unicode.org/reports/tr35/tr35-numbers.html#Operands
+//
+//     - n: absolute value of the source number
+//     - i: integer digits of n
+//     - v: number of visible fraction digits in n, with trailing zeros
+//     - w: number of visible fraction digits in n, without trailing zeros
+//     - f: visible fraction digits in n, with trailing zeros
+//     - t: visible fraction digits in n, without trailing zeros
+//     - e: exponent of the power of 10 used in compact decimal formatting
+type pluralOperands struct {
+	n, i, v, w, f, t, e float64
+}
+
+// newPluralOperands returns the plural operands for a float64. Only the
+// fraction digits needed to represent the float are considered visible.
+func newPluralOperands(number float64) *pluralOperands {
+	o := new(pluralOperands)
+	o.n = math.Abs(number)
+	o.i = math.Floor(o.n)
+
+	str := strconv.FormatFloat(o.n, 'f', -1, 64)
+	if pos := strings.Index(str, "."); pos != -1 {
+		fraction := str[pos+1:]
+		trimmed := strings.TrimRight(fraction, "0")
+
+		o.v = float64(len(fraction))
+		o.w = float64(len(trimmed))
+		o.f, _ = strconv.ParseFloat(fraction, 64)
+		o.t, _ = strconv.ParseFloat("0"+trimmed, 64)
+	}
+
+	return o
+}
+
+// operand returns the value of the named operand
+func (o *pluralOperands) operand(name string) float64 {
+	switch name {
+	case "i":
+		return o.i
+	case "v":
+		return o.v
+	case "w":
+		return o.w
+	case "f":
+		return o.f
+	case "t":
+		return o.t
+	case "e", "c":
+		return o.e
+	}
+	return o.n
+}
+
+// parsePluralOperands returns the plural operands for a formatted number
+// string, like "1,234.50". The decimal and group symbols are the ones used to
+// format the number. Any other characters that aren't digits, like currency
+// symbols, are ignored. ok is false if the string doesn't contain a number.
+func parsePluralOperands(numberStr string, decimal string, group string) (o *pluralOperands, ok bool) {
+	if decimal == "" {
+		decimal = "."
+	}
+
+	if group != "" && group != decimal {
+		numberStr = strings.Replace(numberStr, group, "", -1)
+	}
+
+	integer := ""
+	fraction := ""
+	if pos := strings.Index(numberStr, decimal); pos != -1 {
+		integer = digits(numberStr[:pos])
+		fraction = digits(numberStr[pos+len(decimal):])
+	} else {
+		integer = digits(numberStr)
+	}
+
+	if integer == "" && fraction == "" {
+		return
+	}
+
+	o = new(pluralOperands)
+	o.i, _ = strconv.ParseFloat("0"+integer, 64)
+	o.n = o.i
+
+	if fraction != "" {
+		trimmed := strings.TrimRight(fraction, "0")
+
+		o.n, _ = strconv.ParseFloat("0"+integer+"."+fraction, 64)
+		o.v = float64(len(fraction))
+		o.w = float64(len(trimmed))
+		o.f, _ = strconv.ParseFloat(fraction, 64)
+		o.t, _ = strconv.ParseFloat("0"+trimmed, 64)
+	}
+
+	ok = true
+	return
+}
+
+// digits returns only the ASCII digits in str
+func digits(str string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, str)
+}
+
+// pluralOperandsRule is a compiled plural rule, which returns the CLDR plural
+// category for a number's plural operands
+type pluralOperandsRule func(o *pluralOperands) pluralCategory
+
+// pluralRule returns a pluralRule which uses the plural operands of a float64,
+// so only the fraction digits needed to represent the float are visible
+func (r pluralOperandsRule) pluralRule() pluralRule {
+	return func(number float64) pluralCategory {
+		return r(newPluralOperands(number))
+	}
+}
+
+// pluralCondition is a compiled CLDR plural rule condition, like
+// "i = 1 and v = 0"
+type pluralCondition func(o *pluralOperands) bool
+
+// compilePluralRule compiles a map of CLDR plural category names to plural rule
+// conditions into a pluralOperandsRule. The "other" category doesn't need a
+// condition, as it's used for every number that doesn't match one of the other
+// categories. The plural categories the rule can return are also returned, in
+// CLDR order.
+//
+// Conditions use the syntax described here:
+// http://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+//
+// The older "is", "is not", "in", "not in", "within" and "mod" keywords are
+// also supported. Samples starting with "@integer" or "@decimal" are ignored.
+func compilePluralRule(rules map[string]string) (rule pluralOperandsRule, categories []pluralCategory, err error) {
+	conditions := make(map[pluralCategory]pluralCondition)
+
+	for name, expression := range rules {
+		category, ok := pluralCategoryFromName(name)
+		if !ok {
+			err = translatorError{message: "invalid plural category: " + name}
+			return
+		}
+
+		if category == pluralCategoryOther {
+			continue
+		}
+
+		var condition pluralCondition
+		condition, err = parsePluralCondition(expression)
+		if err != nil {
+			return
+		}
+
+		conditions[category] = condition
+	}
+
+	for c := pluralCategoryZero; c < pluralCategoryOther; c++ {
+		if _, ok := conditions[c]; ok {
+			categories = append(categories, c)
+		}
+	}
+	categories = append(categories, pluralCategoryOther)
+
+	// the conditions are checked in CLDR order - they shouldn't overlap, but
+	// this keeps the results stable if they do
+	checked := categories[:len(categories)-1]
+
+	rule = func(o *pluralOperands) pluralCategory {
+		for _, c := range checked {
+			if conditions[c](o) {
+				return c
+			}
+		}
+		return pluralCategoryOther
+	}
+
+	return
+}
+
+// pluralRuleParser parses a single CLDR plural rule condition
+type pluralRuleParser struct {
+	expression string
+	tokens     []string
+	pos        int
+}
+
+// parsePluralCondition parses a CLDR plural rule condition into a
+// pluralCondition
+func parsePluralCondition(expression string) (condition pluralCondition, err error) {
+	p := &pluralRuleParser{expression: expression}
+
+	// samples aren't part of the condition
+	if pos := strings.Index(expression, "@"); pos != -1 {
+		expression = expression[:pos]
+	}
+
+	p.tokens, err = tokenizePluralCondition(expression)
+	if err != nil {
+		return
+	}
+
+	if len(p.tokens) == 0 {
+		err = p.error("empty condition")
+		return
+	}
+
+	condition, err = p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = p.error("unexpected " + p.tokens[p.pos])
+	}
+
+	return
+}
+
+// tokenizePluralCondition splits a plural rule condition into keywords,
+// operands, numbers and symbols
+func tokenizePluralCondition(expression string) (tokens []string, err error) {
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case unicode.IsLetter(r):
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+		case unicode.IsDigit(r):
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+		case r == '.' && i+1 < len(runes) && runes[i+1] == '.':
+			i += 2
+		case r == '!' && i+1 < len(runes) && runes[i+1] == '=':
+			i += 2
+		case r == '=' || r == ',' || r == '%':
+			i++
+		default:
+			err = translatorError{message: "invalid plural rule condition: " + expression}
+			return
+		}
+
+		tokens = append(tokens, strings.ToLower(string(runes[start:i])))
+	}
+
+	return
+}
+
+// parseOr parses conditions joined with "or"
+func (p *pluralRuleParser) parseOr() (condition pluralCondition, err error) {
+	var conditions []pluralCondition
+
+	for {
+		var and pluralCondition
+		and, err = p.parseAnd()
+		if err != nil {
+			return
+		}
+		conditions = append(conditions, and)
+
+		if !p.consume("or") {
+			break
+		}
+	}
+
+	condition = func(o *pluralOperands) bool {
+		for _, c := range conditions {
+			if c(o) {
+				return true
+			}
+		}
+		return false
+	}
+	return
+}
+
+// parseAnd parses relations joined with "and"
+func (p *pluralRuleParser) parseAnd() (condition pluralCondition, err error) {
+	var relations []pluralCondition
+
+	for {
+		var relation pluralCondition
+		relation, err = p.parseRelation()
+		if err != nil {
+			return
+		}
+		relations = append(relations, relation)
+
+		if !p.consume("and") {
+			break
+		}
+	}
+
+	condition = func(o *pluralOperands) bool {
+		for _, r := range relations {
+			if !r(o) {
+				return false
+			}
+		}
+		return true
+	}
+	return
+}
+
+// parseRelation parses a single relation, like "n % 10 = 2..4,9"
+func (p *pluralRuleParser) parseRelation() (condition pluralCondition, err error) {
+	operand := p.next()
+	if len(operand) != 1 || !strings.Contains("nivwftec", operand) {
+		err = p.error("invalid operand " + operand)
+		return
+	}
+
+	modulus := float64(0)
+	if p.consume("%") || p.consume("mod") {
+		modulus, err = p.parseNumber()
+		if err != nil {
+			return
+		}
+		if modulus == 0 {
+			err = p.error("modulus of zero")
+			return
+		}
+	}
+
+	negate := false
+	within := false
+
+	switch {
+	case p.consume("="):
+	case p.consume("!="):
+		negate = true
+	case p.consume("is"):
+		negate = p.consume("not")
+	default:
+		negate = p.consume("not")
+		if p.consume("within") {
+			within = true
+		} else if !p.consume("in") {
+			err = p.error("missing operator")
+			return
+		}
+	}
+
+	var ranges [][2]float64
+	ranges, err = p.parseRanges()
+	if err != nil {
+		return
+	}
+
+	condition = func(o *pluralOperands) bool {
+		value := o.operand(operand)
+		if modulus != 0 {
+			value = math.Mod(value, modulus)
+		}
+
+		matched := false
+		for _, r := range ranges {
+			if r[0] == r[1] {
+				matched = value == r[0]
+			} else if within || isInt(value) {
+				matched = value >= r[0] && value <= r[1]
+			}
+			if matched {
+				break
+			}
+		}
+
+		return matched != negate
+	}
+	return
+}
+
+// parseRanges parses a comma separated list of values and ranges, like
+// "2..4,9"
+func (p *pluralRuleParser) parseRanges() (ranges [][2]float64, err error) {
+	for {
+		var low, high float64
+		low, err = p.parseNumber()
+		if err != nil {
+			return
+		}
+
+		high = low
+		if p.consume("..") {
+			high, err = p.parseNumber()
+			if err != nil {
+				return
+			}
+		}
+
+		ranges = append(ranges, [2]float64{low, high})
+
+		if !p.consume(",") {
+			break
+		}
+	}
+	return
+}
+
+// parseNumber parses a single integer value
+func (p *pluralRuleParser) parseNumber() (number float64, err error) {
+	token := p.next()
+
+	number, parseErr := strconv.ParseFloat(token, 64)
+	if parseErr != nil || token == "" || !unicode.IsDigit(rune(token[0])) {
+		err = p.error("expected a number, found " + token)
+	}
+	return
+}
+
+// next returns the next token and moves past it
+func (p *pluralRuleParser) next() (token string) {
+	if p.pos < len(p.tokens) {
+		token = p.tokens[p.pos]
+		p.pos++
+	}
+	return
+}
+
+// consume moves past the next token if it's the expected one
+func (p *pluralRuleParser) consume(expected string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos] == expected {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// error returns a translatorError for a malformed condition
+func (p *pluralRuleParser) error(message string) error {
+	return translatorError{message: "invalid plural rule condition: " + p.expression + ": " + message}
+}
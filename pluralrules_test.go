@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestNewPluralOperands(c *C) {
+	o := newPluralOperands(-1.5)
+	c.Check(*o, Equals, pluralOperands{n: 1.5, i: 1, v: 1, w: 1, f: 5, t: 5})
+
+	o = newPluralOperands(3)
+	c.Check(*o, Equals, pluralOperands{n: 3, i: 3})
+
+	o = newPluralOperands(1.025)
+	c.Check(*o, Equals, pluralOperands{n: 1.025, i: 1, v: 3, w: 3, f: 25, t: 25})
+}
+
+func (s *MySuite) TestParsePluralOperands(c *C) {
+	o, ok := parsePluralOperands("1.0", ".", ",")
+	c.Check(ok, Equals, true)
+	c.Check(*o, Equals, pluralOperands{n: 1, i: 1, v: 1, w: 0, f: 0, t: 0})
+
+	o, ok = parsePluralOperands("-1,234.50", ".", ",")
+	c.Check(ok, Equals, true)
+	c.Check(*o, Equals, pluralOperands{n: 1234.5, i: 1234, v: 2, w: 1, f: 50, t: 5})
+
+	// locale symbols
+	o, ok = parsePluralOperands("1\u00a0234,05\u00a0€", ",", "\u00a0")
+	c.Check(ok, Equals, true)
+	c.Check(*o, Equals, pluralOperands{n: 1234.05, i: 1234, v: 2, w: 2, f: 5, t: 5})
+
+	_, ok = parsePluralOperands("two", ".", ",")
+	c.Check(ok, Equals, false)
+}
+
+func (s *MySuite) TestCompilePluralRule(c *C) {
+	// CLDR syntax, with samples
+	operandsRule, categories, err := compilePluralRule(map[string]string{
+		"one":   "i = 1 and v = 0 @integer 1",
+		"other": " @integer 0, 2~16, 100, 1000, … @decimal 0.0~1.5, 10.0, …",
+	})
+	c.Assert(err, IsNil)
+	c.Check(categories, DeepEquals, []pluralCategory{pluralCategoryOne, pluralCategoryOther})
+
+	rule := operandsRule.pluralRule()
+	c.Check(rule(1), Equals, pluralCategoryOne)
+	c.Check(rule(1.5), Equals, pluralCategoryOther)
+	c.Check(rule(2), Equals, pluralCategoryOther)
+
+	// ranges and value lists
+	operandsRule, _, err = compilePluralRule(map[string]string{
+		"few":  "n % 10 = 2..4,9 and n % 100 != 12..14",
+		"many": "n = 5..8",
+	})
+	c.Assert(err, IsNil)
+	rule = operandsRule.pluralRule()
+	c.Check(rule(3), Equals, pluralCategoryFew)
+	c.Check(rule(9), Equals, pluralCategoryFew)
+	c.Check(rule(13), Equals, pluralCategoryOther)
+	c.Check(rule(6), Equals, pluralCategoryMany)
+	c.Check(rule(6.5), Equals, pluralCategoryOther)
+
+	// the older keywords
+	operandsRule, _, err = compilePluralRule(map[string]string{
+		"zero": "n is 0",
+		"one":  "n within 0..2 and n is not 0 and n is not 2",
+		"two":  "n mod 10 in 2 and n not in 12",
+	})
+	c.Assert(err, IsNil)
+	rule = operandsRule.pluralRule()
+	c.Check(rule(0), Equals, pluralCategoryZero)
+	c.Check(rule(1.5), Equals, pluralCategoryOne)
+	c.Check(rule(22), Equals, pluralCategoryTwo)
+	c.Check(rule(12), Equals, pluralCategoryOther)
+
+	// malformed rules
+	for _, condition := range []string{"", "x = 1", "n = ", "n = 1 and", "n % 0 = 1", "n 1", "n = 1..", "n = 1 # 2", "n = 1 2"} {
+		_, _, err = compilePluralRule(map[string]string{"one": condition})
+		c.Check(err, NotNil, Commentf("condition %q", condition))
+	}
+
+	_, _, err = compilePluralRule(map[string]string{"lots": "n = 1"})
+	c.Check(err, NotNil)
+}
@@ -1,17 +1,60 @@
 package i18n
 
-import (
-	"math"
+// pluralRule is a function that takes a single float64 and returns the CLDR
+// plural category to use for that number. pluralRules are compiled from CLDR
+// plural rule conditions by compilePluralRule.
+type pluralRule func(float64) pluralCategory
+
+// pluralCategory is one of the CLDR plural categories. The constants are in
+// CLDR order, which is also the order plural variants appear in "|" delimited
+// plural messages.
+type pluralCategory int
+
+// the CLDR plural categories
+const (
+	pluralCategoryZero pluralCategory = iota
+	pluralCategoryOne
+	pluralCategoryTwo
+	pluralCategoryFew
+	pluralCategoryMany
+	pluralCategoryOther
 )
 
-// pluralRule is a function that takes a single float64 and returns an int.  Its
-// intended use is to return an int index for what plural form to use for the
-// given float
-type pluralRule func(float64) int
+// pluralCategoryNames contains the names used for each plural category in
+// messages files
+var pluralCategoryNames = map[pluralCategory]string{
+	pluralCategoryZero:  "zero",
+	pluralCategoryOne:   "one",
+	pluralCategoryTwo:   "two",
+	pluralCategoryFew:   "few",
+	pluralCategoryMany:  "many",
+	pluralCategoryOther: "other",
+}
+
+// String returns the CLDR name of the plural category
+func (c pluralCategory) String() string {
+	if name, ok := pluralCategoryNames[c]; ok {
+		return name
+	}
+	return pluralCategoryNames[pluralCategoryOther]
+}
+
+// pluralCategoryFromName returns the plural category with the given CLDR name
+func pluralCategoryFromName(name string) (category pluralCategory, ok bool) {
+	for c, n := range pluralCategoryNames {
+		if n == name {
+			return c, true
+		}
+	}
+	return pluralCategoryOther, false
+}
 
-// pluralRules contains the list of all pluralRule functions. The string map
-// index is used when loading plural rules from yaml files
-var pluralRules = map[string]pluralRule{
+// pluralRules contains the CLDR plural rule conditions for each of the named
+// plural rules. The string map index is used when loading plural rules from
+// yaml files, and the conditions are compiled into a pluralRule at that time.
+// Locales whose plural rules aren't one of these can list their conditions
+// directly in their rules yaml file instead.
+var pluralRules = map[string]map[string]string{
 	"1":  pluralRule1,
 	"2A": pluralRule2A,
 	"2B": pluralRule2B,
@@ -46,7 +89,7 @@ func isInt(n float64) bool {
 }
 
 // pluralRule1:
-// Logic for calculating the nth plural for languages with no plurals
+// Rules for calculating the nth plural for languages with no plurals
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -92,12 +135,10 @@ func isInt(n float64) bool {
 //     - wo:  Wolof
 //     - yo:  Yoruba
 //     - zh:  Chinese
-func pluralRule1(n float64) int {
-	return 0
-}
+var pluralRule1 = map[string]string{}
 
 // pluralRule2A:
-// Logic for calculating the nth plural for Spanish or languages who share the same rules as Spanish
+// Rules for calculating the nth plural for Spanish or languages who share the same rules as Spanish
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -224,20 +265,12 @@ func pluralRule1(n float64) int {
 //     - xh:  Xhosa
 //     - xog: Soga
 //     - zu:  Zulu
-func pluralRule2A(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	}
-	return 1
+var pluralRule2A = map[string]string{
+	"one": "i = 1 and v = 0",
 }
 
 // pluralRule2B:
-// Logic for calculating the nth plural for Hindi or languages who share the same rules as Hindi
+// Rules for calculating the nth plural for Hindi or languages who share the same rules as Hindi
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -270,20 +303,12 @@ func pluralRule2A(n float64) int {
 //     - tl:  Tagalog
 //     - uz:  Uzbek
 //     - wa:  Walloon
-func pluralRule2B(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && (i == 0 || i == 1):
-		return 0
-	}
-	return 1
+var pluralRule2B = map[string]string{
+	"one": "n = 0,1",
 }
 
 // pluralRule2C:
-// Logic for calculating the nth plural for French or languages who share the same rules as French
+// Rules for calculating the nth plural for French or languages who share the same rules as French
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -300,18 +325,12 @@ func pluralRule2B(n float64) int {
 //     - ff:  Fulah
 //     - fr:  French
 //     - kab: Kabyle
-func pluralRule2C(n float64) int {
-	abs := math.Abs(n)
-
-	switch {
-	case abs >= 0 && abs < 2:
-		return 0
-	}
-	return 1
+var pluralRule2C = map[string]string{
+	"one": "i = 0,1",
 }
 
 // pluralRule2D:
-// Logic for calculating the nth plural for Macedonian or languages who share the same rules as
+// Rules for calculating the nth plural for Macedonian or languages who share the same rules as
 // Macedonian
 //
 // Plural Forms Rules Documented here:
@@ -327,23 +346,12 @@ func pluralRule2C(n float64) int {
 //
 // Languages:
 //     - mk: Macedonian
-func pluralRule2D(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-
-	switch {
-	case isInt && mod10 == 1 && i != 11:
-		return 0
-	}
-
-	return 1
+var pluralRule2D = map[string]string{
+	"one": "n % 10 = 1 and n != 11",
 }
 
 // pluralRule2E:
-// Logic for calculating the nth plural for Central Atlas Tamazight or languages who share the same
+// Rules for calculating the nth plural for Central Atlas Tamazight or languages who share the same
 // rules as Central Atlas Tamazight
 //
 // Plural Forms Rules Documented here:
@@ -359,21 +367,12 @@ func pluralRule2D(n float64) int {
 //
 // Languages:
 //     - tzm: Central Atlas Tamazight
-func pluralRule2E(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && (i == 0 || i == 1 || (i >= 11 && i <= 99)):
-		return 0
-	}
-
-	return 1
+var pluralRule2E = map[string]string{
+	"one": "n = 0,1 or n = 11..99",
 }
 
 // pluralRule2F:
-// Logic for calculating the nth plural for Manx or languages who share the same rules as Manx
+// Rules for calculating the nth plural for Manx or languages who share the same rules as Manx
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -388,24 +387,12 @@ func pluralRule2E(n float64) int {
 //
 // Languages:
 //     - gv: Manx
-func pluralRule2F(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod20 := i % 20
-
-	switch {
-	case isInt && (mod10 == 1 || mod10 == 2 || mod20 == 0):
-		return 0
-	}
-
-	return 1
+var pluralRule2F = map[string]string{
+	"one": "n % 10 = 1,2 or n % 20 = 0",
 }
 
 // pluralRule3A:
-// Logic for calculating the nth plural for Latvian or languages who share the same rules as Latvian
+// Rules for calculating the nth plural for Latvian or languages who share the same rules as Latvian
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -423,22 +410,13 @@ func pluralRule2F(n float64) int {
 //
 // Languages:
 //     - lv: Latvian
-func pluralRule3A(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 0:
-		return 0
-	case isInt && i%10 == 1 && i%100 != 11:
-		return 1
-	}
-	return 2
+var pluralRule3A = map[string]string{
+	"zero": "n = 0",
+	"one":  "n % 10 = 1 and n % 100 != 11",
 }
 
 // pluralRule3B:
-// Logic for calculating the nth plural for Nama or languages who share the same rules as Nama
+// Rules for calculating the nth plural for Nama or languages who share the same rules as Nama
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -464,22 +442,13 @@ func pluralRule3A(n float64) int {
 //     - smj: Lule Sami
 //     - smn: Inari Sami
 //     - sms: Skolt Sami
-func pluralRule3B(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && i == 2:
-		return 1
-	}
-	return 2
+var pluralRule3B = map[string]string{
+	"one": "n = 1",
+	"two": "n = 2",
 }
 
 // pluralRule3C:
-// Logic for calculating the nth plural for Romanian or languages who share the same rules as
+// Rules for calculating the nth plural for Romanian or languages who share the same rules as
 // Romanian
 //
 // Plural Forms Rules Documented here:
@@ -499,22 +468,13 @@ func pluralRule3B(n float64) int {
 // Languages:
 //     - ro: Romanian
 //     - mo: Moldavian
-func pluralRule3C(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && (i == 0 || (i%100 >= 1 && i%100 <= 19)):
-		return 1
-	}
-	return 2
+var pluralRule3C = map[string]string{
+	"one": "n = 1",
+	"few": "n = 0 or n % 100 = 1..19",
 }
 
 // pluralRule3D:
-// Logic for calculating the nth plural for Lithuanian or languages who share the same rules as
+// Rules for calculating the nth plural for Lithuanian or languages who share the same rules as
 // Lithuanian
 //
 // Plural Forms Rules Documented here:
@@ -533,29 +493,13 @@ func pluralRule3C(n float64) int {
 //
 // Languages:
 //     - lt: Lithuanian
-func pluralRule3D(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod100 := i % 100
-
-	switch {
-	// the part in the parentheses should be replaced with just mod100 !- 11, right?  I've seen this
-	// same logically expression so many places that I'm doubting my own logical thinking, so I've
-	// implemented it like I've seen it implemented elsewhere.
-	case isInt && mod10 == 1 && (mod100 < 11 || mod100 > 19):
-		return 0
-	case isInt && mod10 >= 2 && mod10 <= 9 && (mod100 < 11 || mod100 > 19):
-		return 1
-	}
-
-	return 2
+var pluralRule3D = map[string]string{
+	"one": "n % 10 = 1 and n % 100 != 11..19",
+	"few": "n % 10 = 2..9 and n % 100 != 11..19",
 }
 
 // pluralRule3E:
-// Logic for calculating the nth plural for Czech or languages who share the same rules as Czech
+// Rules for calculating the nth plural for Czech or languages who share the same rules as Czech
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -574,23 +518,13 @@ func pluralRule3D(n float64) int {
 // Languages:
 //     - cs: Czech
 //     - sk: Slovak
-func pluralRule3E(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && i >= 2 && i <= 4:
-		return 1
-	}
-
-	return 2
+var pluralRule3E = map[string]string{
+	"one": "i = 1 and v = 0",
+	"few": "i = 2..4 and v = 0",
 }
 
 // pluralRule3F:
-// Logic for calculating the nth plural for Langi or languages who share the same rules as Langi
+// Rules for calculating the nth plural for Langi or languages who share the same rules as Langi
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -608,24 +542,13 @@ func pluralRule3E(n float64) int {
 //
 // Languages:
 //     - lag: Langi
-func pluralRule3F(n float64) int {
-
-	isInt := isInt(n)
-	abs := math.Abs(n)
-	i := int64(abs)
-
-	switch {
-	case isInt && i == 0:
-		return 0
-	case abs > 0 && abs < 2:
-		return 1
-	}
-
-	return 2
+var pluralRule3F = map[string]string{
+	"zero": "n = 0",
+	"one":  "i = 0,1 and n != 0",
 }
 
 // pluralRule3G:
-// Logic for calculating the nth plural for Tachelhit or languages who share the same rules as
+// Rules for calculating the nth plural for Tachelhit or languages who share the same rules as
 // Tachelhit
 //
 // Plural Forms Rules Documented here:
@@ -644,24 +567,13 @@ func pluralRule3F(n float64) int {
 //
 // Languages:
 //     - shi: Tachelhit
-func pluralRule3G(n float64) int {
-
-	isInt := isInt(n)
-	abs := math.Abs(n)
-	i := int64(abs)
-
-	switch {
-	case abs >= 0 && abs <= 1:
-		return 0
-	case isInt && i >= 2 && i <= 10:
-		return 1
-	}
-
-	return 2
+var pluralRule3G = map[string]string{
+	"one": "i = 0 or n = 1",
+	"few": "n = 2..10",
 }
 
 // pluralRule3H:
-// Logic for calculating the nth plural for Colognian or languages who share the same rules as
+// Rules for calculating the nth plural for Colognian or languages who share the same rules as
 // Colognian
 //
 // Plural Forms Rules Documented here:
@@ -681,23 +593,13 @@ func pluralRule3G(n float64) int {
 // Languages:
 //     - ksh: Colognian
 //     - mnk: Mandinka
-func pluralRule3H(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 0:
-		return 0
-	case isInt && i == 1:
-		return 1
-	}
-
-	return 2
+var pluralRule3H = map[string]string{
+	"zero": "n = 0",
+	"one":  "n = 1",
 }
 
 // pluralRule3I:
-// Logic for calculating the nth plural for Kashubian or languages who share the same rules as
+// Rules for calculating the nth plural for Kashubian or languages who share the same rules as
 // Kashubian
 //
 // Plural Forms Rules Documented here:
@@ -716,26 +618,13 @@ func pluralRule3H(n float64) int {
 //
 // Languages:
 //     - csb: Kashubian
-func pluralRule3I(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod100 := i % 100
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && (mod10 >= 2 && mod10 <= 4 && (mod100 < 10 || mod100 > 19)):
-		return 1
-	}
-
-	return 2
+var pluralRule3I = map[string]string{
+	"one": "n = 1",
+	"few": "n % 10 = 2..4 and n % 100 != 10..19",
 }
 
 // pluralRule4A:
-// Logic for calculating the nth plural for Hebrew or languages who share the same rules as Hebrew
+// Rules for calculating the nth plural for Hebrew or languages who share the same rules as Hebrew
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -756,24 +645,14 @@ func pluralRule3I(n float64) int {
 //
 // Languages:
 //     - he: Hebrew
-func pluralRule4A(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && i == 2:
-		return 1
-	case isInt && i != 0 && i%10 == 0:
-		return 2
-	}
-	return 3
+var pluralRule4A = map[string]string{
+	"one":  "n = 1",
+	"two":  "n = 2",
+	"many": "n != 0 and n % 10 = 0",
 }
 
 // pluralRule4B:
-// Logic for calculating the nth plural for Russian or languages who share the same rules as Russian
+// Rules for calculating the nth plural for Russian or languages who share the same rules as Russian
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -800,28 +679,14 @@ func pluralRule4A(n float64) int {
 //     - sh: Serbo-Croatian
 //     - sr: Serbian
 //     - uk: Ukrainian
-func pluralRule4B(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod100 := i % 100
-
-	switch {
-	case isInt && mod10 == 1 && mod100 != 11:
-		return 0
-	case isInt && (mod10 >= 2 && mod10 <= 4) && (mod100 < 12 || mod100 > 14):
-		return 1
-	case isInt && (mod10 == 0 || (mod10 >= 5 && mod10 <= 9) || (mod100 >= 11 && mod100 <= 14)):
-		return 2
-	}
-
-	return 3
+var pluralRule4B = map[string]string{
+	"one":  "n % 10 = 1 and n % 100 != 11",
+	"few":  "n % 10 = 2..4 and n % 100 != 12..14",
+	"many": "n % 10 = 0 or n % 10 = 5..9 or n % 100 = 11..14",
 }
 
 // pluralRule4C:
-// Logic for calculating the nth plural for Polish or languages who share the same rules as Polish
+// Rules for calculating the nth plural for Polish or languages who share the same rules as Polish
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -842,28 +707,14 @@ func pluralRule4B(n float64) int {
 //
 // Languages:
 //     - pl: Polish
-func pluralRule4C(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod100 := i % 100
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
-		return 1
-	case isInt && ((mod10 >= 0 && mod10 <= 1) || (mod10 >= 5 && mod10 <= 9) || (mod100 >= 12 && mod100 <= 14)):
-		return 2
-	}
-
-	return 3
+var pluralRule4C = map[string]string{
+	"one":  "n = 1",
+	"few":  "n % 10 = 2..4 and n % 100 != 12..14",
+	"many": "n % 10 = 0..1 or n % 10 = 5..9 or n % 100 = 12..14",
 }
 
 // pluralRule4D:
-// Logic for calculating the nth plural for Slovenian or languages who share the same rules as
+// Rules for calculating the nth plural for Slovenian or languages who share the same rules as
 // Slovenian
 //
 // Plural Forms Rules Documented here:
@@ -888,27 +739,14 @@ func pluralRule4C(n float64) int {
 //     - hsb: Upper Sorbian
 //     - sl:  Slovenian
 //     - wen: Sorbian Language
-func pluralRule4D(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod100 := i % 100
-
-	switch {
-	case isInt && mod100 == 1:
-		return 0
-	case isInt && mod100 == 2:
-		return 1
-	case isInt && mod100 >= 3 && mod100 <= 4:
-		return 2
-	}
-
-	return 3
+var pluralRule4D = map[string]string{
+	"one": "n % 100 = 1",
+	"two": "n % 100 = 2",
+	"few": "n % 100 = 3..4",
 }
 
 // pluralRule4E:
-// Logic for calculating the nth plural for Maltese or languages who share the same rules as Maltese
+// Rules for calculating the nth plural for Maltese or languages who share the same rules as Maltese
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -929,27 +767,14 @@ func pluralRule4D(n float64) int {
 //
 // Languages:
 //     - mt: Maltese
-func pluralRule4E(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod100 := i % 100
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && (i == 0 || (mod100 >= 2 && mod100 <= 10)):
-		return 1
-	case isInt && mod100 >= 11 && mod100 <= 19:
-		return 2
-	}
-
-	return 3
+var pluralRule4E = map[string]string{
+	"one":  "n = 1",
+	"few":  "n = 0 or n % 100 = 2..10",
+	"many": "n % 100 = 11..19",
 }
 
 // pluralRule4F:
-// Logic for calculating the nth plural for Scottish Gaelic or languages who share the same rules as
+// Rules for calculating the nth plural for Scottish Gaelic or languages who share the same rules as
 // Scottish Gaelic
 //
 // Plural Forms Rules Documented here:
@@ -971,25 +796,14 @@ func pluralRule4E(n float64) int {
 //
 // Languages:
 //     - gd: Scottish Gaelic
-func pluralRule4F(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && (i == 1 || i == 11):
-		return 0
-	case isInt && (i == 2 || i == 12):
-		return 1
-	case isInt && ((i >= 3 && i <= 10) || (i >= 13 && i <= 19)):
-		return 2
-	}
-
-	return 3
+var pluralRule4F = map[string]string{
+	"one": "n = 1,11",
+	"two": "n = 2,12",
+	"few": "n = 3..10,13..19",
 }
 
 // pluralRule5A:
-// Logic for calculating the nth plural for Irish or languages who share the same rules as Irish
+// Rules for calculating the nth plural for Irish or languages who share the same rules as Irish
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -1013,26 +827,15 @@ func pluralRule4F(n float64) int {
 //
 // Languages:
 //     - ga: Irish
-func pluralRule5A(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 1:
-		return 0
-	case isInt && i == 2:
-		return 1
-	case isInt && i >= 3 && i <= 6:
-		return 2
-	case isInt && i >= 7 && i <= 10:
-		return 3
-	}
-	return 4
+var pluralRule5A = map[string]string{
+	"one":  "n = 1",
+	"two":  "n = 2",
+	"few":  "n = 3..6",
+	"many": "n = 7..10",
 }
 
 // pluralRule5B:
-// Logic for calculating the nth plural for Breton or languages who share the same rules as Breton
+// Rules for calculating the nth plural for Breton or languages who share the same rules as Breton
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -1056,30 +859,15 @@ func pluralRule5A(n float64) int {
 //
 // Languages:
 //     - br: Breton
-func pluralRule5B(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	mod10 := i % 10
-	mod100 := i % 100
-
-	switch {
-	case isInt && mod10 == 1 && mod100 != 11 && mod100 != 71 && mod100 != 91:
-		return 0
-	case isInt && mod10 == 2 && mod100 != 12 && mod100 != 72 && mod100 != 92:
-		return 1
-	case isInt && (mod10 == 3 || mod10 == 4 || mod10 == 9) && (mod100 < 10 || mod100 > 19) && (mod100 < 70 || mod100 > 79) && (mod100 < 90 || mod100 > 99):
-		return 2
-	case isInt && i != 0 && i%1000000 == 0:
-		return 3
-	}
-
-	return 4
+var pluralRule5B = map[string]string{
+	"one":  "n % 10 = 1 and n % 100 != 11,71,91",
+	"two":  "n % 10 = 2 and n % 100 != 12,72,92",
+	"few":  "n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99",
+	"many": "n != 0 and n % 1000000 = 0",
 }
 
 // pluralRule6A:
-// Logic for calculating the nth plural for Arabic or languages who share the same rules as Arabic
+// Rules for calculating the nth plural for Arabic or languages who share the same rules as Arabic
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -1106,28 +894,16 @@ func pluralRule5B(n float64) int {
 //
 // Languages:
 //     - ar: Arabic
-func pluralRule6A(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 0:
-		return 0
-	case isInt && i == 1:
-		return 1
-	case isInt && i == 2:
-		return 2
-	case isInt && i%100 >= 3 && i%100 <= 10:
-		return 3
-	case isInt && i%100 >= 11:
-		return 4
-	}
-	return 5
+var pluralRule6A = map[string]string{
+	"zero": "n = 0",
+	"one":  "n = 1",
+	"two":  "n = 2",
+	"few":  "n % 100 = 3..10",
+	"many": "n % 100 = 11..99",
 }
 
 // pluralRule6B:
-// Logic for calculating the nth plural for Welsh or languages who share the same rules as Welsh
+// Rules for calculating the nth plural for Welsh or languages who share the same rules as Welsh
 //
 // Plural Forms Rules Documented here:
 // https://developer.mozilla.org/en/docs/Localization_and_Plurals
@@ -1154,23 +930,10 @@ func pluralRule6A(n float64) int {
 //
 // Languages:
 //     - cy: Welsh
-func pluralRule6B(n float64) int {
-
-	isInt := isInt(n)
-	i := int64(math.Abs(n))
-
-	switch {
-	case isInt && i == 0:
-		return 0
-	case isInt && i == 1:
-		return 1
-	case isInt && i == 2:
-		return 2
-	case isInt && i == 3:
-		return 3
-	case isInt && i == 6:
-		return 4
-	}
-
-	return 5
+var pluralRule6B = map[string]string{
+	"zero": "n = 0",
+	"one":  "n = 1",
+	"two":  "n = 2",
+	"few":  "n = 3",
+	"many": "n = 6",
 }
@@ -18,623 +18,692 @@ func (s *MySuite) TestIsInt(c *C) {
 	c.Check(isInt(float64(0.00000000000001)), Equals, false)
 }
 
+func (s *MySuite) TestPluralRules(c *C) {
+	// every rule must compile, with one more category than it has conditions
+	for name, rules := range pluralRules {
+		_, categories, err := compilePluralRule(rules)
+		c.Check(err, IsNil, Commentf("plural rule %s", name))
+		c.Check(categories, HasLen, len(rules)+1)
+	}
+}
+
 func (s *MySuite) TestPluralRule1(c *C) {
-	c.Check(pluralRule1(float64(0)), Equals, 0)
-	c.Check(pluralRule1(float64(0.5)), Equals, 0)
-	c.Check(pluralRule1(float64(100)), Equals, 0)
+	rule := compileTestRule(c, pluralRules["1"])
+
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2A(c *C) {
+	rule := compileTestRule(c, pluralRules["2A"])
+
 	// first form
-	c.Check(pluralRule2A(float64(-1)), Equals, 0)
-	c.Check(pluralRule2A(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2A(float64(0)), Equals, 1)
-	c.Check(pluralRule2A(float64(0.5)), Equals, 1)
-	c.Check(pluralRule2A(float64(2)), Equals, 1)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2B(c *C) {
+	rule := compileTestRule(c, pluralRules["2B"])
+
 	// first form
-	c.Check(pluralRule2B(float64(-1)), Equals, 0)
-	c.Check(pluralRule2B(float64(0)), Equals, 0)
-	c.Check(pluralRule2B(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2B(float64(0.5)), Equals, 1)
-	c.Check(pluralRule2B(float64(2)), Equals, 1)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2C(c *C) {
+	rule := compileTestRule(c, pluralRules["2C"])
+
 	// first form
-	c.Check(pluralRule2C(float64(-1)), Equals, 0)
-	c.Check(pluralRule2C(float64(0)), Equals, 0)
-	c.Check(pluralRule2C(float64(0.5)), Equals, 0)
-	c.Check(pluralRule2C(float64(1)), Equals, 0)
-	c.Check(pluralRule2C(float64(1.5)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2C(float64(2)), Equals, 1)
-	c.Check(pluralRule2C(float64(2.5)), Equals, 1)
-	c.Check(pluralRule2C(float64(100)), Equals, 1)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2D(c *C) {
+	rule := compileTestRule(c, pluralRules["2D"])
+
 	// first form
-	c.Check(pluralRule2D(float64(-1)), Equals, 0)
-	c.Check(pluralRule2D(float64(1)), Equals, 0)
-	c.Check(pluralRule2D(float64(21)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2D(float64(0)), Equals, 1)
-	c.Check(pluralRule2D(float64(0.5)), Equals, 1)
-	c.Check(pluralRule2D(float64(2)), Equals, 1)
-	c.Check(pluralRule2D(float64(11)), Equals, 1)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2E(c *C) {
+	rule := compileTestRule(c, pluralRules["2E"])
+
 	// first form
-	c.Check(pluralRule2E(float64(-1)), Equals, 0)
-	c.Check(pluralRule2E(float64(0)), Equals, 0)
-	c.Check(pluralRule2E(float64(1)), Equals, 0)
-	c.Check(pluralRule2E(float64(11)), Equals, 0)
-	c.Check(pluralRule2E(float64(12)), Equals, 0)
-	c.Check(pluralRule2E(float64(98)), Equals, 0)
-	c.Check(pluralRule2E(float64(99)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(98)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(99)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2E(float64(0.5)), Equals, 1)
-	c.Check(pluralRule2E(float64(2)), Equals, 1)
-	c.Check(pluralRule2E(float64(10)), Equals, 1)
-	c.Check(pluralRule2E(float64(100)), Equals, 1)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule2F(c *C) {
+	rule := compileTestRule(c, pluralRules["2F"])
+
 	// first form
-	c.Check(pluralRule2F(float64(-1)), Equals, 0)
-	c.Check(pluralRule2F(float64(0)), Equals, 0)
-	c.Check(pluralRule2F(float64(1)), Equals, 0)
-	c.Check(pluralRule2F(float64(2)), Equals, 0)
-	c.Check(pluralRule2F(float64(11)), Equals, 0)
-	c.Check(pluralRule2F(float64(12)), Equals, 0)
-	c.Check(pluralRule2F(float64(20)), Equals, 0)
-	c.Check(pluralRule2F(float64(40)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(40)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule2F(float64(0.5)), Equals, 1)
-	c.Check(pluralRule2F(float64(3)), Equals, 1)
-	c.Check(pluralRule2F(float64(10)), Equals, 1)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3A(c *C) {
+	rule := compileTestRule(c, pluralRules["3A"])
+
 	// first form
-	c.Check(pluralRule3A(float64(0)), Equals, 0)
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
 
 	// second form
-	c.Check(pluralRule3A(float64(-1)), Equals, 1)
-	c.Check(pluralRule3A(float64(1)), Equals, 1)
-	c.Check(pluralRule3A(float64(21)), Equals, 1)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
 
 	// third form
-	c.Check(pluralRule3A(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3A(float64(2)), Equals, 2)
-	c.Check(pluralRule3A(float64(10)), Equals, 2)
-	c.Check(pluralRule3A(float64(11)), Equals, 2)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3B(c *C) {
+	rule := compileTestRule(c, pluralRules["3B"])
+
 	// first form
-	c.Check(pluralRule3B(float64(-1)), Equals, 0)
-	c.Check(pluralRule3B(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3B(float64(-2)), Equals, 1)
-	c.Check(pluralRule3B(float64(2)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule3B(float64(0)), Equals, 2)
-	c.Check(pluralRule3B(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3B(float64(3)), Equals, 2)
-	c.Check(pluralRule3B(float64(11)), Equals, 2)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3C(c *C) {
+	rule := compileTestRule(c, pluralRules["3C"])
+
 	// first form
-	c.Check(pluralRule3C(float64(-1)), Equals, 0)
-	c.Check(pluralRule3C(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3C(float64(0)), Equals, 1)
-	c.Check(pluralRule3C(float64(-11)), Equals, 1)
-	c.Check(pluralRule3C(float64(11)), Equals, 1)
-	c.Check(pluralRule3C(float64(19)), Equals, 1)
-	c.Check(pluralRule3C(float64(111)), Equals, 1)
-	c.Check(pluralRule3C(float64(119)), Equals, 1)
+	c.Check(rule(float64(0)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(-11)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(11)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(19)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(111)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(119)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule3C(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3C(float64(20)), Equals, 2)
-	c.Check(pluralRule3C(float64(21)), Equals, 2)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3D(c *C) {
+	rule := compileTestRule(c, pluralRules["3D"])
+
 	// first form
-	c.Check(pluralRule3D(float64(-1)), Equals, 0)
-	c.Check(pluralRule3D(float64(1)), Equals, 0)
-	c.Check(pluralRule3D(float64(21)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3D(float64(-2)), Equals, 1)
-	c.Check(pluralRule3D(float64(2)), Equals, 1)
-	c.Check(pluralRule3D(float64(9)), Equals, 1)
-	c.Check(pluralRule3D(float64(22)), Equals, 1)
-	c.Check(pluralRule3D(float64(29)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(9)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(22)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(29)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule3D(float64(0)), Equals, 2)
-	c.Check(pluralRule3D(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3D(float64(11)), Equals, 2)
-	c.Check(pluralRule3D(float64(19)), Equals, 2)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(19)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3E(c *C) {
+	rule := compileTestRule(c, pluralRules["3E"])
+
 	// first form
-	c.Check(pluralRule3E(float64(-1)), Equals, 0)
-	c.Check(pluralRule3E(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3E(float64(-2)), Equals, 1)
-	c.Check(pluralRule3E(float64(2)), Equals, 1)
-	c.Check(pluralRule3E(float64(3)), Equals, 1)
-	c.Check(pluralRule3E(float64(4)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule3E(float64(0)), Equals, 2)
-	c.Check(pluralRule3E(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3E(float64(5)), Equals, 2)
-	c.Check(pluralRule3E(float64(9)), Equals, 2)
-	c.Check(pluralRule3E(float64(11)), Equals, 2)
-	c.Check(pluralRule3E(float64(12)), Equals, 2)
-	c.Check(pluralRule3E(float64(14)), Equals, 2)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(9)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3F(c *C) {
+	rule := compileTestRule(c, pluralRules["3F"])
+
 	// first form
-	c.Check(pluralRule3F(float64(0)), Equals, 0)
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
 
 	// second form
-	c.Check(pluralRule3F(float64(-0.5)), Equals, 1)
-	c.Check(pluralRule3F(float64(0.5)), Equals, 1)
-	c.Check(pluralRule3F(float64(1)), Equals, 1)
-	c.Check(pluralRule3F(float64(1.5)), Equals, 1)
+	c.Check(rule(float64(-0.5)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOne)
 
 	// third form
-	c.Check(pluralRule3F(float64(-2)), Equals, 2)
-	c.Check(pluralRule3F(float64(2)), Equals, 2)
-	c.Check(pluralRule3F(float64(3)), Equals, 2)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3G(c *C) {
+	rule := compileTestRule(c, pluralRules["3G"])
+
 	// first form
-	c.Check(pluralRule3G(float64(-0.5)), Equals, 0)
-	c.Check(pluralRule3G(float64(0)), Equals, 0)
-	c.Check(pluralRule3G(float64(0.5)), Equals, 0)
-	c.Check(pluralRule3G(float64(1)), Equals, 0)
+	c.Check(rule(float64(-0.5)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3G(float64(-2)), Equals, 1)
-	c.Check(pluralRule3G(float64(2)), Equals, 1)
-	c.Check(pluralRule3G(float64(3)), Equals, 1)
-	c.Check(pluralRule3G(float64(9)), Equals, 1)
-	c.Check(pluralRule3G(float64(10)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(9)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(10)), Equals, pluralCategoryFew)
 
 	// third
-	c.Check(pluralRule3G(float64(1.5)), Equals, 2)
-	c.Check(pluralRule3G(float64(11)), Equals, 2)
-	c.Check(pluralRule3G(float64(12)), Equals, 2)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3H(c *C) {
+	rule := compileTestRule(c, pluralRules["3H"])
+
 	// first form
-	c.Check(pluralRule3H(float64(0)), Equals, 0)
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
 
 	// second form
-	c.Check(pluralRule3H(float64(-1)), Equals, 1)
-	c.Check(pluralRule3H(float64(1)), Equals, 1)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// third form
-	c.Check(pluralRule3H(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3H(float64(1.5)), Equals, 2)
-	c.Check(pluralRule3H(float64(2)), Equals, 2)
-	c.Check(pluralRule3H(float64(10)), Equals, 2)
-	c.Check(pluralRule3H(float64(11)), Equals, 2)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(2)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule3I(c *C) {
+	rule := compileTestRule(c, pluralRules["3I"])
+
 	// first form
-	c.Check(pluralRule3I(float64(-1)), Equals, 0)
-	c.Check(pluralRule3I(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule3I(float64(-2)), Equals, 1)
-	c.Check(pluralRule3I(float64(2)), Equals, 1)
-	c.Check(pluralRule3I(float64(3)), Equals, 1)
-	c.Check(pluralRule3I(float64(4)), Equals, 1)
-	c.Check(pluralRule3I(float64(22)), Equals, 1)
-	c.Check(pluralRule3I(float64(23)), Equals, 1)
-	c.Check(pluralRule3I(float64(24)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(22)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(23)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(24)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule3I(float64(0)), Equals, 2)
-	c.Check(pluralRule3I(float64(0.5)), Equals, 2)
-	c.Check(pluralRule3I(float64(5)), Equals, 2)
-	c.Check(pluralRule3I(float64(9)), Equals, 2)
-	c.Check(pluralRule3I(float64(12)), Equals, 2)
-	c.Check(pluralRule3I(float64(13)), Equals, 2)
-	c.Check(pluralRule3I(float64(14)), Equals, 2)
-	c.Check(pluralRule3I(float64(15)), Equals, 2)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(9)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(15)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4A(c *C) {
+	rule := compileTestRule(c, pluralRules["4A"])
+
 	// first form
-	c.Check(pluralRule4A(float64(-1)), Equals, 0)
-	c.Check(pluralRule4A(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4A(float64(-2)), Equals, 1)
-	c.Check(pluralRule4A(float64(2)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule4A(float64(-10)), Equals, 2)
-	c.Check(pluralRule4A(float64(10)), Equals, 2)
-	c.Check(pluralRule4A(float64(20)), Equals, 2)
-	c.Check(pluralRule4A(float64(100)), Equals, 2)
+	c.Check(rule(float64(-10)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(20)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(100)), Equals, pluralCategoryMany)
 
 	// fourth form
-	c.Check(pluralRule4A(float64(0)), Equals, 3)
-	c.Check(pluralRule4A(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4A(float64(3)), Equals, 3)
-	c.Check(pluralRule4A(float64(9)), Equals, 3)
-	c.Check(pluralRule4A(float64(11)), Equals, 3)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(3)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(9)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4B(c *C) {
+	rule := compileTestRule(c, pluralRules["4B"])
+
 	// first form
-	c.Check(pluralRule4B(float64(-1)), Equals, 0)
-	c.Check(pluralRule4B(float64(1)), Equals, 0)
-	c.Check(pluralRule4B(float64(21)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4B(float64(-2)), Equals, 1)
-	c.Check(pluralRule4B(float64(2)), Equals, 1)
-	c.Check(pluralRule4B(float64(3)), Equals, 1)
-	c.Check(pluralRule4B(float64(4)), Equals, 1)
-	c.Check(pluralRule4B(float64(22)), Equals, 1)
-	c.Check(pluralRule4B(float64(23)), Equals, 1)
-	c.Check(pluralRule4B(float64(24)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(22)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(23)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(24)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule4B(float64(-5)), Equals, 2)
-	c.Check(pluralRule4B(float64(0)), Equals, 2)
-	c.Check(pluralRule4B(float64(5)), Equals, 2)
-	c.Check(pluralRule4B(float64(6)), Equals, 2)
-	c.Check(pluralRule4B(float64(8)), Equals, 2)
-	c.Check(pluralRule4B(float64(9)), Equals, 2)
-	c.Check(pluralRule4B(float64(11)), Equals, 2)
-	c.Check(pluralRule4B(float64(12)), Equals, 2)
-	c.Check(pluralRule4B(float64(13)), Equals, 2)
-	c.Check(pluralRule4B(float64(14)), Equals, 2)
+	c.Check(rule(float64(-5)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(0)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(5)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(8)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(9)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(12)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(13)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(14)), Equals, pluralCategoryMany)
 
 	// fourth form
-	c.Check(pluralRule4B(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4B(float64(1.5)), Equals, 3)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4C(c *C) {
+	rule := compileTestRule(c, pluralRules["4C"])
+
 	// first form
-	c.Check(pluralRule4C(float64(-1)), Equals, 0)
-	c.Check(pluralRule4C(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4C(float64(-2)), Equals, 1)
-	c.Check(pluralRule4C(float64(2)), Equals, 1)
-	c.Check(pluralRule4C(float64(3)), Equals, 1)
-	c.Check(pluralRule4C(float64(4)), Equals, 1)
-	c.Check(pluralRule4C(float64(22)), Equals, 1)
-	c.Check(pluralRule4C(float64(23)), Equals, 1)
-	c.Check(pluralRule4C(float64(24)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(22)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(23)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(24)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule4C(float64(-10)), Equals, 2)
-	c.Check(pluralRule4C(float64(10)), Equals, 2)
-	c.Check(pluralRule4C(float64(11)), Equals, 2)
-	c.Check(pluralRule4C(float64(12)), Equals, 2)
-	c.Check(pluralRule4C(float64(13)), Equals, 2)
-	c.Check(pluralRule4C(float64(14)), Equals, 2)
-	c.Check(pluralRule4C(float64(15)), Equals, 2)
-	c.Check(pluralRule4C(float64(16)), Equals, 2)
-	c.Check(pluralRule4C(float64(18)), Equals, 2)
-	c.Check(pluralRule4C(float64(19)), Equals, 2)
-	c.Check(pluralRule4C(float64(20)), Equals, 2)
-	c.Check(pluralRule4C(float64(21)), Equals, 2)
-	c.Check(pluralRule4C(float64(25)), Equals, 2)
-	c.Check(pluralRule4C(float64(26)), Equals, 2)
-	c.Check(pluralRule4C(float64(28)), Equals, 2)
-	c.Check(pluralRule4C(float64(29)), Equals, 2)
+	c.Check(rule(float64(-10)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(12)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(13)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(14)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(15)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(16)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(18)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(19)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(20)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(21)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(25)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(26)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(28)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(29)), Equals, pluralCategoryMany)
 
 	// fourth form
-	c.Check(pluralRule4C(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4C(float64(1.5)), Equals, 3)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1.5)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4D(c *C) {
+	rule := compileTestRule(c, pluralRules["4D"])
+
 	// first form
-	c.Check(pluralRule4D(float64(-1)), Equals, 0)
-	c.Check(pluralRule4D(float64(1)), Equals, 0)
-	c.Check(pluralRule4D(float64(101)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(101)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4D(float64(-2)), Equals, 1)
-	c.Check(pluralRule4D(float64(2)), Equals, 1)
-	c.Check(pluralRule4D(float64(102)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(102)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule4D(float64(-3)), Equals, 2)
-	c.Check(pluralRule4D(float64(3)), Equals, 2)
-	c.Check(pluralRule4D(float64(4)), Equals, 2)
-	c.Check(pluralRule4D(float64(103)), Equals, 2)
-	c.Check(pluralRule4D(float64(104)), Equals, 2)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(103)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(104)), Equals, pluralCategoryFew)
 
 	// fourth form
-	c.Check(pluralRule4D(float64(0)), Equals, 3)
-	c.Check(pluralRule4D(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4D(float64(5)), Equals, 3)
-	c.Check(pluralRule4D(float64(10)), Equals, 3)
-	c.Check(pluralRule4D(float64(11)), Equals, 3)
-	c.Check(pluralRule4D(float64(12)), Equals, 3)
-	c.Check(pluralRule4D(float64(13)), Equals, 3)
-	c.Check(pluralRule4D(float64(14)), Equals, 3)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4E(c *C) {
+	rule := compileTestRule(c, pluralRules["4E"])
+
 	// first form
-	c.Check(pluralRule4E(float64(-1)), Equals, 0)
-	c.Check(pluralRule4E(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4E(float64(-2)), Equals, 1)
-	c.Check(pluralRule4E(float64(0)), Equals, 1)
-	c.Check(pluralRule4E(float64(2)), Equals, 1)
-	c.Check(pluralRule4E(float64(10)), Equals, 1)
-	c.Check(pluralRule4E(float64(102)), Equals, 1)
-	c.Check(pluralRule4E(float64(110)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(0)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(2)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(10)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(102)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(110)), Equals, pluralCategoryFew)
 
 	// third form
-	c.Check(pluralRule4E(float64(-11)), Equals, 2)
-	c.Check(pluralRule4E(float64(11)), Equals, 2)
-	c.Check(pluralRule4E(float64(19)), Equals, 2)
-	c.Check(pluralRule4E(float64(111)), Equals, 2)
-	c.Check(pluralRule4E(float64(119)), Equals, 2)
+	c.Check(rule(float64(-11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(19)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(111)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(119)), Equals, pluralCategoryMany)
 
 	// fourth form
-	c.Check(pluralRule4E(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4E(float64(20)), Equals, 3)
-	c.Check(pluralRule4E(float64(21)), Equals, 3)
-	c.Check(pluralRule4E(float64(22)), Equals, 3)
-	c.Check(pluralRule4E(float64(29)), Equals, 3)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(22)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(29)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule4F(c *C) {
+	rule := compileTestRule(c, pluralRules["4F"])
+
 	// first form
-	c.Check(pluralRule4F(float64(-1)), Equals, 0)
-	c.Check(pluralRule4F(float64(1)), Equals, 0)
-	c.Check(pluralRule4F(float64(11)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule4F(float64(-2)), Equals, 1)
-	c.Check(pluralRule4F(float64(2)), Equals, 1)
-	c.Check(pluralRule4F(float64(12)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(12)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule4F(float64(-3)), Equals, 2)
-	c.Check(pluralRule4F(float64(3)), Equals, 2)
-	c.Check(pluralRule4F(float64(10)), Equals, 2)
-	c.Check(pluralRule4F(float64(13)), Equals, 2)
-	c.Check(pluralRule4F(float64(19)), Equals, 2)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(10)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(13)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(19)), Equals, pluralCategoryFew)
 
 	// fourth form
-	c.Check(pluralRule4F(float64(0)), Equals, 3)
-	c.Check(pluralRule4F(float64(0.5)), Equals, 3)
-	c.Check(pluralRule4F(float64(20)), Equals, 3)
-	c.Check(pluralRule4F(float64(21)), Equals, 3)
-	c.Check(pluralRule4F(float64(22)), Equals, 3)
-	c.Check(pluralRule4F(float64(23)), Equals, 3)
-	c.Check(pluralRule4F(float64(29)), Equals, 3)
-	c.Check(pluralRule4F(float64(101)), Equals, 3)
-	c.Check(pluralRule4F(float64(101)), Equals, 3)
-	c.Check(pluralRule4F(float64(102)), Equals, 3)
-	c.Check(pluralRule4F(float64(103)), Equals, 3)
-	c.Check(pluralRule4F(float64(109)), Equals, 3)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(22)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(23)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(29)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(101)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(101)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(102)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(103)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(109)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule5A(c *C) {
+	rule := compileTestRule(c, pluralRules["5A"])
+
 	// first form
-	c.Check(pluralRule5A(float64(-1)), Equals, 0)
-	c.Check(pluralRule5A(float64(1)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule5A(float64(-2)), Equals, 1)
-	c.Check(pluralRule5A(float64(2)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule5A(float64(-3)), Equals, 2)
-	c.Check(pluralRule5A(float64(3)), Equals, 2)
-	c.Check(pluralRule5A(float64(4)), Equals, 2)
-	c.Check(pluralRule5A(float64(5)), Equals, 2)
-	c.Check(pluralRule5A(float64(6)), Equals, 2)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(5)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(6)), Equals, pluralCategoryFew)
 
 	// fourth form
-	c.Check(pluralRule5A(float64(-7)), Equals, 3)
-	c.Check(pluralRule5A(float64(7)), Equals, 3)
-	c.Check(pluralRule5A(float64(8)), Equals, 3)
-	c.Check(pluralRule5A(float64(9)), Equals, 3)
-	c.Check(pluralRule5A(float64(10)), Equals, 3)
+	c.Check(rule(float64(-7)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(7)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(8)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(9)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10)), Equals, pluralCategoryMany)
 
 	// fifth form
-	c.Check(pluralRule5A(float64(0)), Equals, 4)
-	c.Check(pluralRule5A(float64(0.5)), Equals, 4)
-	c.Check(pluralRule5A(float64(11)), Equals, 4)
-	c.Check(pluralRule5A(float64(12)), Equals, 4)
-	c.Check(pluralRule5A(float64(13)), Equals, 4)
-	c.Check(pluralRule5A(float64(14)), Equals, 4)
-	c.Check(pluralRule5A(float64(15)), Equals, 4)
-	c.Check(pluralRule5A(float64(16)), Equals, 4)
-	c.Check(pluralRule5A(float64(17)), Equals, 4)
-	c.Check(pluralRule5A(float64(18)), Equals, 4)
-	c.Check(pluralRule5A(float64(19)), Equals, 4)
-	c.Check(pluralRule5A(float64(20)), Equals, 4)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(15)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(16)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(17)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(18)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(19)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule5B(c *C) {
+	rule := compileTestRule(c, pluralRules["5B"])
+
 	// first form
-	c.Check(pluralRule5B(float64(-1)), Equals, 0)
-	c.Check(pluralRule5B(float64(1)), Equals, 0)
-	c.Check(pluralRule5B(float64(21)), Equals, 0)
-	c.Check(pluralRule5B(float64(61)), Equals, 0)
-	c.Check(pluralRule5B(float64(81)), Equals, 0)
-	c.Check(pluralRule5B(float64(101)), Equals, 0)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(21)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(61)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(81)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(101)), Equals, pluralCategoryOne)
 
 	// second form
-	c.Check(pluralRule5B(float64(-2)), Equals, 1)
-	c.Check(pluralRule5B(float64(2)), Equals, 1)
-	c.Check(pluralRule5B(float64(22)), Equals, 1)
-	c.Check(pluralRule5B(float64(62)), Equals, 1)
-	c.Check(pluralRule5B(float64(82)), Equals, 1)
-	c.Check(pluralRule5B(float64(102)), Equals, 1)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(22)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(62)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(82)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(102)), Equals, pluralCategoryTwo)
 
 	// third form
-	c.Check(pluralRule5B(float64(-3)), Equals, 2)
-	c.Check(pluralRule5B(float64(3)), Equals, 2)
-	c.Check(pluralRule5B(float64(4)), Equals, 2)
-	c.Check(pluralRule5B(float64(9)), Equals, 2)
-	c.Check(pluralRule5B(float64(23)), Equals, 2)
-	c.Check(pluralRule5B(float64(24)), Equals, 2)
-	c.Check(pluralRule5B(float64(29)), Equals, 2)
-	c.Check(pluralRule5B(float64(63)), Equals, 2)
-	c.Check(pluralRule5B(float64(64)), Equals, 2)
-	c.Check(pluralRule5B(float64(69)), Equals, 2)
-	c.Check(pluralRule5B(float64(83)), Equals, 2)
-	c.Check(pluralRule5B(float64(84)), Equals, 2)
-	c.Check(pluralRule5B(float64(89)), Equals, 2)
-	c.Check(pluralRule5B(float64(103)), Equals, 2)
-	c.Check(pluralRule5B(float64(104)), Equals, 2)
-	c.Check(pluralRule5B(float64(109)), Equals, 2)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(9)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(23)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(24)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(29)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(63)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(64)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(69)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(83)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(84)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(89)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(103)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(104)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(109)), Equals, pluralCategoryFew)
 
 	// fourth form
-	c.Check(pluralRule5B(float64(-1000000)), Equals, 3)
-	c.Check(pluralRule5B(float64(1000000)), Equals, 3)
-	c.Check(pluralRule5B(float64(2000000)), Equals, 3)
-	c.Check(pluralRule5B(float64(10000000)), Equals, 3)
+	c.Check(rule(float64(-1000000)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(1000000)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(2000000)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(10000000)), Equals, pluralCategoryMany)
 
 	// fourth form
-	c.Check(pluralRule5B(float64(0)), Equals, 4)
-	c.Check(pluralRule5B(float64(0.5)), Equals, 4)
-	c.Check(pluralRule5B(float64(10)), Equals, 4)
-	c.Check(pluralRule5B(float64(11)), Equals, 4)
-	c.Check(pluralRule5B(float64(12)), Equals, 4)
-	c.Check(pluralRule5B(float64(13)), Equals, 4)
-	c.Check(pluralRule5B(float64(14)), Equals, 4)
-	c.Check(pluralRule5B(float64(19)), Equals, 4)
-	c.Check(pluralRule5B(float64(20)), Equals, 4)
-	c.Check(pluralRule5B(float64(71)), Equals, 4)
-	c.Check(pluralRule5B(float64(72)), Equals, 4)
-	c.Check(pluralRule5B(float64(73)), Equals, 4)
-	c.Check(pluralRule5B(float64(74)), Equals, 4)
-	c.Check(pluralRule5B(float64(79)), Equals, 4)
-	c.Check(pluralRule5B(float64(91)), Equals, 4)
-	c.Check(pluralRule5B(float64(92)), Equals, 4)
-	c.Check(pluralRule5B(float64(93)), Equals, 4)
-	c.Check(pluralRule5B(float64(94)), Equals, 4)
-	c.Check(pluralRule5B(float64(99)), Equals, 4)
-	c.Check(pluralRule5B(float64(100)), Equals, 4)
-	c.Check(pluralRule5B(float64(1000)), Equals, 4)
-	c.Check(pluralRule5B(float64(10000)), Equals, 4)
-	c.Check(pluralRule5B(float64(100000)), Equals, 4)
+	c.Check(rule(float64(0)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(14)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(19)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(20)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(71)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(72)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(73)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(74)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(79)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(91)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(92)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(93)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(94)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(99)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(1000)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10000)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100000)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule6A(c *C) {
+	rule := compileTestRule(c, pluralRules["6A"])
+
 	// first form
-	c.Check(pluralRule6A(float64(0)), Equals, 0)
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
 
 	// second form
-	c.Check(pluralRule6A(float64(-1)), Equals, 1)
-	c.Check(pluralRule6A(float64(1)), Equals, 1)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// third form
-	c.Check(pluralRule6A(float64(-2)), Equals, 2)
-	c.Check(pluralRule6A(float64(2)), Equals, 2)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
 
 	// fourth form
-	c.Check(pluralRule6A(float64(-3)), Equals, 3)
-	c.Check(pluralRule6A(float64(3)), Equals, 3)
-	c.Check(pluralRule6A(float64(4)), Equals, 3)
-	c.Check(pluralRule6A(float64(9)), Equals, 3)
-	c.Check(pluralRule6A(float64(10)), Equals, 3)
-	c.Check(pluralRule6A(float64(103)), Equals, 3)
-	c.Check(pluralRule6A(float64(104)), Equals, 3)
-	c.Check(pluralRule6A(float64(109)), Equals, 3)
-	c.Check(pluralRule6A(float64(110)), Equals, 3)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(4)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(9)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(10)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(103)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(104)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(109)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(110)), Equals, pluralCategoryFew)
 
 	// fifth form
-	c.Check(pluralRule6A(float64(-11)), Equals, 4)
-	c.Check(pluralRule6A(float64(11)), Equals, 4)
-	c.Check(pluralRule6A(float64(12)), Equals, 4)
-	c.Check(pluralRule6A(float64(98)), Equals, 4)
-	c.Check(pluralRule6A(float64(99)), Equals, 4)
-	c.Check(pluralRule6A(float64(111)), Equals, 4)
-	c.Check(pluralRule6A(float64(112)), Equals, 4)
-	c.Check(pluralRule6A(float64(198)), Equals, 4)
-	c.Check(pluralRule6A(float64(199)), Equals, 4)
+	c.Check(rule(float64(-11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(11)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(12)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(98)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(99)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(111)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(112)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(198)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(199)), Equals, pluralCategoryMany)
 
 	// sixth form
-	c.Check(pluralRule6A(float64(0.5)), Equals, 5)
-	c.Check(pluralRule6A(float64(100)), Equals, 5)
-	c.Check(pluralRule6A(float64(102)), Equals, 5)
-	c.Check(pluralRule6A(float64(200)), Equals, 5)
-	c.Check(pluralRule6A(float64(202)), Equals, 5)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(100)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(102)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(200)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(202)), Equals, pluralCategoryOther)
 }
 
 func (s *MySuite) TestPluralRule6B(c *C) {
+	rule := compileTestRule(c, pluralRules["6B"])
+
 	// first form
-	c.Check(pluralRule6B(float64(0)), Equals, 0)
+	c.Check(rule(float64(0)), Equals, pluralCategoryZero)
 
 	// second form
-	c.Check(pluralRule6B(float64(-1)), Equals, 1)
-	c.Check(pluralRule6B(float64(1)), Equals, 1)
+	c.Check(rule(float64(-1)), Equals, pluralCategoryOne)
+	c.Check(rule(float64(1)), Equals, pluralCategoryOne)
 
 	// third form
-	c.Check(pluralRule6B(float64(-2)), Equals, 2)
-	c.Check(pluralRule6B(float64(2)), Equals, 2)
+	c.Check(rule(float64(-2)), Equals, pluralCategoryTwo)
+	c.Check(rule(float64(2)), Equals, pluralCategoryTwo)
 
 	// fourth form
-	c.Check(pluralRule6B(float64(-3)), Equals, 3)
-	c.Check(pluralRule6B(float64(3)), Equals, 3)
+	c.Check(rule(float64(-3)), Equals, pluralCategoryFew)
+	c.Check(rule(float64(3)), Equals, pluralCategoryFew)
 
 	// fifth form
-	c.Check(pluralRule6B(float64(-6)), Equals, 4)
-	c.Check(pluralRule6B(float64(6)), Equals, 4)
+	c.Check(rule(float64(-6)), Equals, pluralCategoryMany)
+	c.Check(rule(float64(6)), Equals, pluralCategoryMany)
 
 	// sixth form
-	c.Check(pluralRule6B(float64(0.5)), Equals, 5)
-	c.Check(pluralRule6B(float64(4)), Equals, 5)
-	c.Check(pluralRule6B(float64(5)), Equals, 5)
-	c.Check(pluralRule6B(float64(7)), Equals, 5)
-	c.Check(pluralRule6B(float64(8)), Equals, 5)
-	c.Check(pluralRule6B(float64(9)), Equals, 5)
-	c.Check(pluralRule6B(float64(10)), Equals, 5)
-	c.Check(pluralRule6B(float64(11)), Equals, 5)
-	c.Check(pluralRule6B(float64(12)), Equals, 5)
-	c.Check(pluralRule6B(float64(13)), Equals, 5)
-	c.Check(pluralRule6B(float64(16)), Equals, 5)
+	c.Check(rule(float64(0.5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(4)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(5)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(7)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(8)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(9)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(10)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(11)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(12)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(13)), Equals, pluralCategoryOther)
+	c.Check(rule(float64(16)), Equals, pluralCategoryOther)
+}
+
+// compileTestRule compiles plural rule conditions, failing the test if they
+// don't compile
+func compileTestRule(c *C, rules map[string]string) pluralRule {
+	rule, _, err := compilePluralRule(rules)
+	c.Assert(err, IsNil)
+	return rule.pluralRule()
 }
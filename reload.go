@@ -0,0 +1,238 @@
+package i18n
+
+import (
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Reload rebuilds every Translator the factory has created from its rules and
+// message sources, and then swaps them all in at once, so GetTranslator never
+// returns a mix of old and new Translators. Translators that were already
+// returned by GetTranslator aren't changed - call GetTranslator again to get
+// the reloaded ones.
+//
+// If a locale's Translator can't be rebuilt without errors, like when one of
+// its messages files can't be parsed, the factory keeps the locale's last good
+// Translator, and the errors are returned. Warnings (see IsWarning), like a
+// locale with rules but no messages files of its own, and locales with neither,
+// which only use their fallbacks, don't stop a Translator from being rebuilt,
+// and aren't returned. Translators for more specific
+// locales fall back to the kept Translator, and kept Translators fall back to
+// their rebuilt fallbacks.
+//
+// Functions registered with OnReload are called after the swap.
+func (f *TranslatorFactory) Reload() (errors []error) {
+	f.reloadMutex.Lock()
+	defer f.reloadMutex.Unlock()
+
+	f.mutex.RLock()
+	old := make(map[string]*Translator, len(f.translators))
+	for locale, t := range f.translators {
+		old[locale] = t
+	}
+	oldFallback := f.fallback
+	f.mutex.RUnlock()
+
+	fallbackLocale := ""
+	if oldFallback != nil {
+		fallbackLocale = oldFallback.locale
+	}
+
+	// build the fallback locale first, and less specific locales before more
+	// specific ones, so the fallbacks of rebuilt Translators are rebuilt (or
+	// kept) first
+	locales := make([]string, 0, len(old))
+	for locale := range old {
+		locales = append(locales, locale)
+	}
+	sort.Slice(locales, func(i, j int) bool {
+		if (locales[i] == fallbackLocale) != (locales[j] == fallbackLocale) {
+			return locales[i] == fallbackLocale
+		}
+		partsI := strings.Count(locales[i], "-")
+		partsJ := strings.Count(locales[j], "-")
+		if partsI != partsJ {
+			return partsI < partsJ
+		}
+		return locales[i] < locales[j]
+	})
+
+	// list the files again, to find new locales and ones that were renamed
+	for _, source := range f.fsSources() {
+		source.reindex()
+	}
+
+	rebuilt := &TranslatorFactory{
+		rulesSources:   f.rulesSources,
+		messageSources: f.messageSources,
+		translators:    map[string]*Translator{},
+	}
+
+	reloaded := []string{}
+	for _, locale := range locales {
+		_, errs := rebuilt.GetTranslator(locale)
+
+		failed := false
+		for _, err := range errs {
+			if !IsWarning(err) && !isNotFound(err) {
+				errors = append(errors, err)
+				failed = true
+			}
+		}
+
+		if failed {
+			rebuilt.translators[locale] = rebuilt.keep(old[locale])
+		} else {
+			reloaded = append(reloaded, locale)
+		}
+
+		if locale == fallbackLocale {
+			rebuilt.fallback = rebuilt.translators[locale]
+		}
+	}
+
+	f.mutex.Lock()
+	f.translators = rebuilt.translators
+	f.fallback = rebuilt.fallback
+	listeners := make([]func(locales []string, errors []error), len(f.listeners))
+	copy(listeners, f.listeners)
+	f.mutex.Unlock()
+
+	for _, listener := range listeners {
+		listener(reloaded, errors)
+	}
+
+	return
+}
+
+// keep returns a locale's last good Translator for a rebuilt factory. If the
+// locale's fallback was rebuilt, a copy of the Translator which falls back to
+// the rebuilt one is returned instead.
+func (f *TranslatorFactory) keep(t *Translator) *Translator {
+	fallback := f.getFallback(t.locale)
+	if fallback == t.fallback {
+		return t
+	}
+
+	kept := *t
+	kept.fallback = fallback
+	return &kept
+}
+
+// OnReload registers a function to call each time the factory is reloaded. It
+// gets the locales whose Translators were rebuilt, and the errors for the
+// ones which kept their last good Translator.
+func (f *TranslatorFactory) OnReload(listener func(locales []string, errors []error)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.listeners = append(f.listeners, listener)
+}
+
+// Watch checks the factory's rules and messages files for changes every
+// interval, and reloads the factory when any files are added, changed or
+// removed. Only sources which load files, like the paths passed to
+// NewTranslatorFactory, are watched. To pick up changes from other sources,
+// like an HTTPSource, call Reload on a timer instead. Call the returned
+// function to stop watching.
+func (f *TranslatorFactory) Watch(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	files := f.watchedFiles()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				current := f.watchedFiles()
+				if !sameFiles(files, current) {
+					files = current
+					f.Reload()
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// watchedFile is the state of a watched file, used to tell when it changes
+type watchedFile struct {
+	size    int64
+	modTime time.Time
+}
+
+// fsSources returns the factory's rules and message sources which load files,
+// without duplicates
+func (f *TranslatorFactory) fsSources() (sources []*FSSource) {
+	seen := map[*FSSource]bool{}
+	add := func(source interface{}) {
+		if fsSource, ok := source.(*FSSource); ok && !seen[fsSource] {
+			seen[fsSource] = true
+			sources = append(sources, fsSource)
+		}
+	}
+
+	for _, source := range f.rulesSources {
+		add(source)
+	}
+	for _, source := range f.messageSources {
+		add(source)
+	}
+
+	return
+}
+
+// watchedFiles returns the state of every file in the factory's file system
+// sources, keyed by the source's position and the file's path
+func (f *TranslatorFactory) watchedFiles() map[string]watchedFile {
+	files := map[string]watchedFile{}
+
+	for i, fsSource := range f.fsSources() {
+		prefix := strconv.Itoa(i) + ":"
+		fs.WalkDir(fsSource.fsys, ".", func(file string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+
+			files[prefix+file] = watchedFile{size: info.Size(), modTime: info.ModTime()}
+			return nil
+		})
+	}
+
+	return files
+}
+
+// sameFiles returns whether two sets of watched files are the same
+func sameFiles(a, b map[string]watchedFile) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for file, state := range a {
+		other, ok := b[file]
+		if !ok || other.size != state.size || !other.modTime.Equal(state.modTime) {
+			return false
+		}
+	}
+
+	return true
+}
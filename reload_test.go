@@ -0,0 +1,196 @@
+package i18n
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestReload(c *C) {
+	dir := c.MkDir()
+	write := func(file, contents string) {
+		err := ioutil.WriteFile(dir+"/"+file, []byte(contents), os.FileMode(0777))
+		c.Assert(err, IsNil)
+	}
+
+	write("en.yaml", `WELCOME: "Welcome!"`)
+	write("en-GB.yaml", `COLOR: "Colour"`)
+
+	f, errors := NewTranslatorFactory([]string{"data/rules"}, []string{dir}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	var reloadedLocales [][]string
+	var reloadErrors [][]error
+	f.OnReload(func(locales []string, errors []error) {
+		reloadedLocales = append(reloadedLocales, locales)
+		reloadErrors = append(reloadErrors, errors)
+	})
+
+	tEn, _ := f.GetTranslator("en")
+	tGB, errors := f.GetTranslator("en-GB")
+	c.Check(errors, HasLen, 0)
+
+	// the typo is fixed
+	write("en.yaml", `WELCOME: "Welcome back!"`)
+
+	errors = f.Reload()
+	c.Check(errors, HasLen, 0)
+	c.Check(reloadedLocales, DeepEquals, [][]string{{"en", "en-GB"}})
+	c.Check(reloadErrors, DeepEquals, [][]error{nil})
+
+	// translators already in use don't change
+	t, _ := tEn.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome!")
+
+	newEn, _ := f.GetTranslator("en")
+	c.Check(newEn, Not(Equals), tEn)
+
+	t, _ = newEn.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome back!")
+
+	// rebuilt translators fall back to rebuilt translators
+	newGB, _ := f.GetTranslator("en-GB")
+	c.Check(newGB, Not(Equals), tGB)
+	c.Check(newGB.fallback, Equals, newEn)
+
+	t, _ = newGB.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome back!")
+
+	// a broken file keeps the last good translator
+	write("en.yaml", `WELCOME: "Welcome`)
+	write("en-GB.yaml", `COLOR: "Color"`)
+
+	errors = f.Reload()
+	c.Check(len(errors) > 0, Equals, true)
+	c.Check(reloadedLocales[1], DeepEquals, []string{"en-GB"})
+	c.Check(reloadErrors[1], DeepEquals, errors)
+
+	t2, _ := f.GetTranslator("en")
+	c.Check(t2, Equals, newEn)
+
+	gb, _ := f.GetTranslator("en-GB")
+	c.Check(gb.fallback, Equals, newEn)
+
+	t, _ = gb.Translate("COLOR", map[string]string{})
+	c.Check(t, Equals, "Color")
+
+	t, _ = gb.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome back!")
+
+	// files for new locales are found after a reload
+	_, errors = f.GetTranslator("de")
+	c.Check(errors, HasLen, 1)
+
+	write("de.yaml", `WELCOME: "Willkommen!"`)
+	f.Reload()
+
+	de, _ := f.GetTranslator("de")
+	t, _ = de.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Willkommen!")
+}
+
+func (s *MySuite) TestReloadFallbacks(c *C) {
+	dir := c.MkDir()
+	write := func(file, contents string) {
+		err := ioutil.WriteFile(dir+"/"+file, []byte(contents), os.FileMode(0777))
+		c.Assert(err, IsNil)
+	}
+
+	write("en.yaml", `WELCOME: "old"`)
+	write("fr.yaml", `WELCOME: "bienvenue"`)
+	write("fr-CA.yaml", `COLOR: "couleur"`)
+
+	f, errors := NewTranslatorFactory([]string{"data/rules"}, []string{dir}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	// en-GB has rules but no messages of its own
+	_, errors = f.GetTranslator("en-GB")
+	c.Check(errors, HasLen, 1)
+	f.GetTranslator("fr-CA")
+
+	// reloading the parent rebuilds the rules-only locale against it
+	write("en.yaml", `WELCOME: "new"`)
+
+	errors = f.Reload()
+	c.Check(errors, HasLen, 0)
+
+	en, _ := f.GetTranslator("en")
+	gb, _ := f.GetTranslator("en-GB")
+	c.Check(gb.fallback, Equals, en)
+
+	t, _ := gb.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "new")
+
+	// a locale which keeps its last good Translator falls back to its
+	// rebuilt parent
+	write("fr.yaml", `WELCOME: "salut"`)
+	write("fr-CA.yaml", `COLOR: "couleur`)
+
+	errors = f.Reload()
+	c.Check(errors, HasLen, 1)
+
+	fr, _ := f.GetTranslator("fr")
+	ca, _ := f.GetTranslator("fr-CA")
+	c.Check(ca.fallback, Equals, fr)
+
+	t, _ = ca.Translate("COLOR", map[string]string{})
+	c.Check(t, Equals, "couleur")
+
+	t, _ = ca.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "salut")
+
+	// locales with neither rules nor messages are rebuilt against their
+	// parents, without errors
+	_, errors = f.GetTranslator("en-ZZ")
+	c.Check(errors, Not(HasLen), 0)
+
+	write("en.yaml", `WELCOME: "newer"`)
+	write("fr-CA.yaml", `COLOR: "couleur"`)
+
+	errors = f.Reload()
+	c.Check(errors, HasLen, 0)
+
+	zz, _ := f.GetTranslator("en-ZZ")
+	c.Check(zz.fallback, Equals, f.translators["en"])
+
+	t, _ = zz.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "newer")
+}
+
+func (s *MySuite) TestWatch(c *C) {
+	dir := c.MkDir()
+	err := ioutil.WriteFile(dir+"/en.yaml", []byte(`WELCOME: "Welcome!"`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	f, errors := NewTranslatorFactory([]string{"data/rules"}, []string{dir}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	reloaded := make(chan []string, 10)
+	f.OnReload(func(locales []string, errors []error) {
+		reloaded <- locales
+	})
+
+	stop := f.Watch(10 * time.Millisecond)
+	defer stop()
+
+	err = ioutil.WriteFile(dir+"/en.yaml", []byte(`WELCOME: "Welcome back!"`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	select {
+	case locales := <-reloaded:
+		c.Check(locales, DeepEquals, []string{"en"})
+	case <-time.After(5 * time.Second):
+		c.Fatal("factory wasn't reloaded")
+	}
+
+	tEn, _ := f.GetTranslator("en")
+	t, _ := tEn.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome back!")
+
+	// nothing changed, so there are no more reloads
+	stop()
+	stop()
+	c.Check(len(reloaded), Equals, 0)
+}
@@ -2,8 +2,12 @@ package i18n
 
 import (
 	// standard library
-	"io/ioutil"
+	"encoding/json"
+	"io/fs"
+	"math"
 	"os"
+	"path"
+	"path/filepath"
 
 	// third party
 	"gopkg.in/yaml.v1"
@@ -19,188 +23,533 @@ const (
 // TranslatorRules is a struct containing all of the information unmarshalled
 // from a locale rules file.
 type TranslatorRules struct {
-	Plural         string `yaml:"plural,omitempty"`
-	PluralRuleFunc pluralRule
-	Direction      string `yaml:"direction,omitempty"`
-	Numbers        struct {
+	Plural            string                       `yaml:"plural,omitempty" json:"plural,omitempty"`
+	PluralRules       map[string]string            `yaml:"pluralRules,omitempty" json:"pluralRules,omitempty"`
+	PluralRuleFunc    pluralRule                   `json:"-"`
+	PluralCategories  []pluralCategory             `json:"-"`
+	Ordinal           string                       `yaml:"ordinal,omitempty" json:"ordinal,omitempty"`
+	OrdinalRules      map[string]string            `yaml:"ordinalRules,omitempty" json:"ordinalRules,omitempty"`
+	OrdinalRuleFunc   pluralRule                   `json:"-"`
+	OrdinalCategories []pluralCategory             `json:"-"`
+	PluralRanges      map[string]map[string]string `yaml:"pluralRanges,omitempty" json:"pluralRanges,omitempty"`
+	Direction         string                       `yaml:"direction,omitempty" json:"direction,omitempty"`
+	Numbers           struct {
 		Symbols struct {
-			Decimal  string `yaml:"decimal,omitempty"`
-			Group    string `yaml:"group,omitempty"`
-			Negative string `yaml:"negative,omitempty"`
-			Percent  string `yaml:"percent,omitempty"`
-			Permille string `yaml:"permille,omitempty"`
-		} `yaml:"symbols,omitempty"`
+			Decimal  string `yaml:"decimal,omitempty" json:"decimal,omitempty"`
+			Group    string `yaml:"group,omitempty" json:"group,omitempty"`
+			Negative string `yaml:"negative,omitempty" json:"negative,omitempty"`
+			Percent  string `yaml:"percent,omitempty" json:"percent,omitempty"`
+			Permille string `yaml:"permille,omitempty" json:"permille,omitempty"`
+		} `yaml:"symbols,omitempty" json:"symbols,omitempty"`
 		Formats struct {
-			Decimal  string `yaml:"decimal,omitempty"`
-			Currency string `yaml:"currency,omitempty"`
-			Percent  string `yaml:"percent,omitempty"`
-		} `yaml:"formats,omitempty"`
-	} `yaml:"numbers,omitempty"`
-	Currencies map[string]currency `yaml:"currencies,omitempty"`
+			Decimal  string `yaml:"decimal,omitempty" json:"decimal,omitempty"`
+			Currency string `yaml:"currency,omitempty" json:"currency,omitempty"`
+			Percent  string `yaml:"percent,omitempty" json:"percent,omitempty"`
+		} `yaml:"formats,omitempty" json:"formats,omitempty"`
+	} `yaml:"numbers,omitempty" json:"numbers,omitempty"`
+	Currencies map[string]currency `yaml:"currencies,omitempty" json:"currencies,omitempty"`
 	DateTime   struct {
-		TimeSeparator string `yaml:"timeSeparator,omitempty"`
+		TimeSeparator string `yaml:"timeSeparator,omitempty" json:"timeSeparator,omitempty"`
 		Formats       struct {
 			Date struct {
-				Full   string `yaml:"full,omitempty"`
-				Long   string `yaml:"long,omitempty"`
-				Medium string `yaml:"medium,omitempty"`
-				Short  string `yaml:"short,omitempty"`
-			} `yaml:"date,omitempty"`
+				Full   string `yaml:"full,omitempty" json:"full,omitempty"`
+				Long   string `yaml:"long,omitempty" json:"long,omitempty"`
+				Medium string `yaml:"medium,omitempty" json:"medium,omitempty"`
+				Short  string `yaml:"short,omitempty" json:"short,omitempty"`
+			} `yaml:"date,omitempty" json:"date,omitempty"`
 			Time struct {
-				Full   string `yaml:"full,omitempty"`
-				Long   string `yaml:"long,omitempty"`
-				Medium string `yaml:"medium,omitempty"`
-				Short  string `yaml:"short,omitempty"`
-			} `yaml:"time,omitempty"`
+				Full   string `yaml:"full,omitempty" json:"full,omitempty"`
+				Long   string `yaml:"long,omitempty" json:"long,omitempty"`
+				Medium string `yaml:"medium,omitempty" json:"medium,omitempty"`
+				Short  string `yaml:"short,omitempty" json:"short,omitempty"`
+			} `yaml:"time,omitempty" json:"time,omitempty"`
 			DateTime struct {
-				Full   string `yaml:"full,omitempty"`
-				Long   string `yaml:"long,omitempty"`
-				Medium string `yaml:"medium,omitempty"`
-				Short  string `yaml:"short,omitempty"`
-			} `yaml:"datetime,omitempty"`
-		} `yaml:"formats,omitempty"`
-		FormatNames struct {
+				Full   string `yaml:"full,omitempty" json:"full,omitempty"`
+				Long   string `yaml:"long,omitempty" json:"long,omitempty"`
+				Medium string `yaml:"medium,omitempty" json:"medium,omitempty"`
+				Short  string `yaml:"short,omitempty" json:"short,omitempty"`
+			} `yaml:"datetime,omitempty" json:"datetime,omitempty"`
+		} `yaml:"formats,omitempty" json:"formats,omitempty"`
+		AvailableFormats map[string]string `yaml:"availableFormats,omitempty" json:"availableFormats,omitempty"`
+		AppendItems      map[string]string `yaml:"appendItems,omitempty" json:"appendItems,omitempty"`
+		FieldNames       map[string]string `yaml:"fieldNames,omitempty" json:"fieldNames,omitempty"`
+		FormatNames      struct {
 			Months struct {
 				Abbreviated struct {
-					Month1  string `yaml:"1,omitempty"`
-					Month2  string `yaml:"2,omitempty"`
-					Month3  string `yaml:"3,omitempty"`
-					Month4  string `yaml:"4,omitempty"`
-					Month5  string `yaml:"5,omitempty"`
-					Month6  string `yaml:"6,omitempty"`
-					Month7  string `yaml:"7,omitempty"`
-					Month8  string `yaml:"8,omitempty"`
-					Month9  string `yaml:"9,omitempty"`
-					Month10 string `yaml:"10,omitempty"`
-					Month11 string `yaml:"11,omitempty"`
-					Month12 string `yaml:"12,omitempty"`
-				} `yaml:"abbreviated,omitempty"`
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
 				Narrow struct {
-					Month1  string `yaml:"1,omitempty"`
-					Month2  string `yaml:"2,omitempty"`
-					Month3  string `yaml:"3,omitempty"`
-					Month4  string `yaml:"4,omitempty"`
-					Month5  string `yaml:"5,omitempty"`
-					Month6  string `yaml:"6,omitempty"`
-					Month7  string `yaml:"7,omitempty"`
-					Month8  string `yaml:"8,omitempty"`
-					Month9  string `yaml:"9,omitempty"`
-					Month10 string `yaml:"10,omitempty"`
-					Month11 string `yaml:"11,omitempty"`
-					Month12 string `yaml:"12,omitempty"`
-				} `yaml:"narrow,omitempty"`
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
 				Wide struct {
-					Month1  string `yaml:"1,omitempty"`
-					Month2  string `yaml:"2,omitempty"`
-					Month3  string `yaml:"3,omitempty"`
-					Month4  string `yaml:"4,omitempty"`
-					Month5  string `yaml:"5,omitempty"`
-					Month6  string `yaml:"6,omitempty"`
-					Month7  string `yaml:"7,omitempty"`
-					Month8  string `yaml:"8,omitempty"`
-					Month9  string `yaml:"9,omitempty"`
-					Month10 string `yaml:"10,omitempty"`
-					Month11 string `yaml:"11,omitempty"`
-					Month12 string `yaml:"12,omitempty"`
-				} `yaml:"wide,omitempty"`
-			} `yaml:"months,omitempty"`
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"months,omitempty" json:"months,omitempty"`
 			Days struct {
 				Abbreviated struct {
-					Sun string `yaml:"sun,omitempty"`
-					Mon string `yaml:"mon,omitempty"`
-					Tue string `yaml:"tue,omitempty"`
-					Wed string `yaml:"wed,omitempty"`
-					Thu string `yaml:"thu,omitempty"`
-					Fri string `yaml:"fri,omitempty"`
-					Sat string `yaml:"sat,omitempty"`
-				} `yaml:"abbreviated,omitempty"`
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
 				Narrow struct {
-					Sun string `yaml:"sun,omitempty"`
-					Mon string `yaml:"mon,omitempty"`
-					Tue string `yaml:"tue,omitempty"`
-					Wed string `yaml:"wed,omitempty"`
-					Thu string `yaml:"thu,omitempty"`
-					Fri string `yaml:"fri,omitempty"`
-					Sat string `yaml:"sat,omitempty"`
-				} `yaml:"narrow,omitempty"`
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
 				Short struct {
-					Sun string `yaml:"sun,omitempty"`
-					Mon string `yaml:"mon,omitempty"`
-					Tue string `yaml:"tue,omitempty"`
-					Wed string `yaml:"wed,omitempty"`
-					Thu string `yaml:"thu,omitempty"`
-					Fri string `yaml:"fri,omitempty"`
-					Sat string `yaml:"sat,omitempty"`
-				} `yaml:"short,omitempty"`
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"short,omitempty" json:"short,omitempty"`
 				Wide struct {
-					Sun string `yaml:"sun,omitempty"`
-					Mon string `yaml:"mon,omitempty"`
-					Tue string `yaml:"tue,omitempty"`
-					Wed string `yaml:"wed,omitempty"`
-					Thu string `yaml:"thu,omitempty"`
-					Fri string `yaml:"fri,omitempty"`
-					Sat string `yaml:"sat,omitempty"`
-				} `yaml:"wide,omitempty"`
-			} `yaml:"days,omitempty"`
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"days,omitempty" json:"days,omitempty"`
 			Periods struct {
 				Abbreviated struct {
-					AM string `yaml:"am,omitempty"`
-					PM string `yaml:"pm,omitempty"`
-				} `yaml:"abbreviated,omitempty"`
+					AM         string `yaml:"am,omitempty" json:"am,omitempty"`
+					PM         string `yaml:"pm,omitempty" json:"pm,omitempty"`
+					Midnight   string `yaml:"midnight,omitempty" json:"midnight,omitempty"`
+					Noon       string `yaml:"noon,omitempty" json:"noon,omitempty"`
+					Morning1   string `yaml:"morning1,omitempty" json:"morning1,omitempty"`
+					Morning2   string `yaml:"morning2,omitempty" json:"morning2,omitempty"`
+					Afternoon1 string `yaml:"afternoon1,omitempty" json:"afternoon1,omitempty"`
+					Afternoon2 string `yaml:"afternoon2,omitempty" json:"afternoon2,omitempty"`
+					Evening1   string `yaml:"evening1,omitempty" json:"evening1,omitempty"`
+					Evening2   string `yaml:"evening2,omitempty" json:"evening2,omitempty"`
+					Night1     string `yaml:"night1,omitempty" json:"night1,omitempty"`
+					Night2     string `yaml:"night2,omitempty" json:"night2,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
 				Narrow struct {
-					AM string `yaml:"am,omitempty"`
-					PM string `yaml:"pm,omitempty"`
-				} `yaml:"narrow,omitempty"`
+					AM         string `yaml:"am,omitempty" json:"am,omitempty"`
+					PM         string `yaml:"pm,omitempty" json:"pm,omitempty"`
+					Midnight   string `yaml:"midnight,omitempty" json:"midnight,omitempty"`
+					Noon       string `yaml:"noon,omitempty" json:"noon,omitempty"`
+					Morning1   string `yaml:"morning1,omitempty" json:"morning1,omitempty"`
+					Morning2   string `yaml:"morning2,omitempty" json:"morning2,omitempty"`
+					Afternoon1 string `yaml:"afternoon1,omitempty" json:"afternoon1,omitempty"`
+					Afternoon2 string `yaml:"afternoon2,omitempty" json:"afternoon2,omitempty"`
+					Evening1   string `yaml:"evening1,omitempty" json:"evening1,omitempty"`
+					Evening2   string `yaml:"evening2,omitempty" json:"evening2,omitempty"`
+					Night1     string `yaml:"night1,omitempty" json:"night1,omitempty"`
+					Night2     string `yaml:"night2,omitempty" json:"night2,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
 				Wide struct {
-					AM string `yaml:"am,omitempty"`
-					PM string `yaml:"pm,omitempty"`
-				} `yaml:"wide,omitempty"`
-			} `yaml:"periods,omitempty"`
-		} `yaml:"formatNames,omitempty"`
-	} `yaml:"datetime,omitempty"`
+					AM         string `yaml:"am,omitempty" json:"am,omitempty"`
+					PM         string `yaml:"pm,omitempty" json:"pm,omitempty"`
+					Midnight   string `yaml:"midnight,omitempty" json:"midnight,omitempty"`
+					Noon       string `yaml:"noon,omitempty" json:"noon,omitempty"`
+					Morning1   string `yaml:"morning1,omitempty" json:"morning1,omitempty"`
+					Morning2   string `yaml:"morning2,omitempty" json:"morning2,omitempty"`
+					Afternoon1 string `yaml:"afternoon1,omitempty" json:"afternoon1,omitempty"`
+					Afternoon2 string `yaml:"afternoon2,omitempty" json:"afternoon2,omitempty"`
+					Evening1   string `yaml:"evening1,omitempty" json:"evening1,omitempty"`
+					Evening2   string `yaml:"evening2,omitempty" json:"evening2,omitempty"`
+					Night1     string `yaml:"night1,omitempty" json:"night1,omitempty"`
+					Night2     string `yaml:"night2,omitempty" json:"night2,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"periods,omitempty" json:"periods,omitempty"`
+			Eras struct {
+				Abbreviated struct {
+					BC string `yaml:"bc,omitempty" json:"bc,omitempty"`
+					AD string `yaml:"ad,omitempty" json:"ad,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
+				Narrow struct {
+					BC string `yaml:"bc,omitempty" json:"bc,omitempty"`
+					AD string `yaml:"ad,omitempty" json:"ad,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
+				Wide struct {
+					BC string `yaml:"bc,omitempty" json:"bc,omitempty"`
+					AD string `yaml:"ad,omitempty" json:"ad,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"eras,omitempty" json:"eras,omitempty"`
+			Quarters struct {
+				Abbreviated struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
+				Narrow struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
+				Wide struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"quarters,omitempty" json:"quarters,omitempty"`
+		} `yaml:"formatNames,omitempty" json:"formatNames,omitempty"`
+		StandAloneNames struct {
+			Months struct {
+				Abbreviated struct {
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
+				Narrow struct {
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
+				Wide struct {
+					Month1  string `yaml:"1,omitempty" json:"1,omitempty"`
+					Month2  string `yaml:"2,omitempty" json:"2,omitempty"`
+					Month3  string `yaml:"3,omitempty" json:"3,omitempty"`
+					Month4  string `yaml:"4,omitempty" json:"4,omitempty"`
+					Month5  string `yaml:"5,omitempty" json:"5,omitempty"`
+					Month6  string `yaml:"6,omitempty" json:"6,omitempty"`
+					Month7  string `yaml:"7,omitempty" json:"7,omitempty"`
+					Month8  string `yaml:"8,omitempty" json:"8,omitempty"`
+					Month9  string `yaml:"9,omitempty" json:"9,omitempty"`
+					Month10 string `yaml:"10,omitempty" json:"10,omitempty"`
+					Month11 string `yaml:"11,omitempty" json:"11,omitempty"`
+					Month12 string `yaml:"12,omitempty" json:"12,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"months,omitempty" json:"months,omitempty"`
+			Days struct {
+				Abbreviated struct {
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
+				Narrow struct {
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
+				Short struct {
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"short,omitempty" json:"short,omitempty"`
+				Wide struct {
+					Sun string `yaml:"sun,omitempty" json:"sun,omitempty"`
+					Mon string `yaml:"mon,omitempty" json:"mon,omitempty"`
+					Tue string `yaml:"tue,omitempty" json:"tue,omitempty"`
+					Wed string `yaml:"wed,omitempty" json:"wed,omitempty"`
+					Thu string `yaml:"thu,omitempty" json:"thu,omitempty"`
+					Fri string `yaml:"fri,omitempty" json:"fri,omitempty"`
+					Sat string `yaml:"sat,omitempty" json:"sat,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"days,omitempty" json:"days,omitempty"`
+			Quarters struct {
+				Abbreviated struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"abbreviated,omitempty" json:"abbreviated,omitempty"`
+				Narrow struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"narrow,omitempty" json:"narrow,omitempty"`
+				Wide struct {
+					Quarter1 string `yaml:"1,omitempty" json:"1,omitempty"`
+					Quarter2 string `yaml:"2,omitempty" json:"2,omitempty"`
+					Quarter3 string `yaml:"3,omitempty" json:"3,omitempty"`
+					Quarter4 string `yaml:"4,omitempty" json:"4,omitempty"`
+				} `yaml:"wide,omitempty" json:"wide,omitempty"`
+			} `yaml:"quarters,omitempty" json:"quarters,omitempty"`
+		} `yaml:"standAloneNames,omitempty" json:"standAloneNames,omitempty"`
+		Week struct {
+			FirstDay string `yaml:"firstDay,omitempty" json:"firstDay,omitempty"`
+			MinDays  int    `yaml:"minDays,omitempty" json:"minDays,omitempty"`
+		} `yaml:"week,omitempty" json:"week,omitempty"`
+		DayPeriodRules map[string]string `yaml:"dayPeriodRules,omitempty" json:"dayPeriodRules,omitempty"`
+		TimeZoneNames  struct {
+			HourFormat    string                   `yaml:"hourFormat,omitempty" json:"hourFormat,omitempty"`
+			GMTFormat     string                   `yaml:"gmtFormat,omitempty" json:"gmtFormat,omitempty"`
+			GMTZeroFormat string                   `yaml:"gmtZeroFormat,omitempty" json:"gmtZeroFormat,omitempty"`
+			RegionFormat  string                   `yaml:"regionFormat,omitempty" json:"regionFormat,omitempty"`
+			Zones         map[string]timeZoneNames `yaml:"zones,omitempty" json:"zones,omitempty"`
+			Metazones     map[string]timeZoneNames `yaml:"metazones,omitempty" json:"metazones,omitempty"`
+		} `yaml:"timeZoneNames,omitempty" json:"timeZoneNames,omitempty"`
+	} `yaml:"datetime,omitempty" json:"datetime,omitempty"`
+
+	// the compiled plural and ordinal rules, which use all of the CLDR plural
+	// operands rather than just a float64
+	pluralOperandsFunc  pluralOperandsRule
+	ordinalOperandsFunc pluralOperandsRule
 }
 
 // currency is a struct that's used in the above TranslatorRules struct for
 // capturing the rule info for a single currency
 type currency struct {
-	Symbol string `yaml:"symbol,omitempty"`
+	Symbol string `yaml:"symbol,omitempty" json:"symbol,omitempty"`
+}
+
+// timeZoneNames is a struct that's used in the above TranslatorRules struct
+// for capturing the names of a single time zone, like "Europe/London", or a
+// metazone, like "Europe_Central"
+type timeZoneNames struct {
+	Long         timeZoneNameSet `yaml:"long,omitempty" json:"long,omitempty"`
+	Short        timeZoneNameSet `yaml:"short,omitempty" json:"short,omitempty"`
+	ExemplarCity string          `yaml:"exemplarCity,omitempty" json:"exemplarCity,omitempty"`
+}
+
+// timeZoneNameSet is the generic, standard time and daylight saving time names
+// of a time zone in one width
+type timeZoneNameSet struct {
+	Generic  string `yaml:"generic,omitempty" json:"generic,omitempty"`
+	Standard string `yaml:"standard,omitempty" json:"standard,omitempty"`
+	Daylight string `yaml:"daylight,omitempty" json:"daylight,omitempty"`
 }
 
-// load unmarshalls rule data from yaml files into the translator's rules
+// merge returns the names with the ones set in newNames replacing them
+func (n timeZoneNames) merge(newNames timeZoneNames) timeZoneNames {
+	n.Long.Generic = stringMerge(n.Long.Generic, newNames.Long.Generic)
+	n.Long.Standard = stringMerge(n.Long.Standard, newNames.Long.Standard)
+	n.Long.Daylight = stringMerge(n.Long.Daylight, newNames.Long.Daylight)
+	n.Short.Generic = stringMerge(n.Short.Generic, newNames.Short.Generic)
+	n.Short.Standard = stringMerge(n.Short.Standard, newNames.Short.Standard)
+	n.Short.Daylight = stringMerge(n.Short.Daylight, newNames.Short.Daylight)
+	n.ExemplarCity = stringMerge(n.ExemplarCity, newNames.ExemplarCity)
+	return n
+}
+
+// mergeTimeZoneNames returns a map of time zone names with the names in
+// newNames merged into the ones in names. The maps aren't changed, because
+// they may be shared with a rules source.
+func mergeTimeZoneNames(names, newNames map[string]timeZoneNames) map[string]timeZoneNames {
+	if len(newNames) == 0 {
+		return names
+	}
+
+	merged := make(map[string]timeZoneNames, len(names)+len(newNames))
+	for zone, zoneNames := range names {
+		merged[zone] = zoneNames
+	}
+	for zone, zoneNames := range newNames {
+		merged[zone] = merged[zone].merge(zoneNames)
+	}
+
+	return merged
+}
+
+// mergeStringMaps returns a map with the values in newValues merged into the
+// ones in values. Like mergeTimeZoneNames, the maps themselves aren't changed.
+func mergeStringMaps(values, newValues map[string]string) map[string]string {
+	if len(newValues) == 0 {
+		return values
+	}
+
+	merged := make(map[string]string, len(values)+len(newValues))
+	for key, value := range values {
+		merged[key] = value
+	}
+	for key, value := range newValues {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// load unmarshalls rule data from yaml files on disk into the translator's
+// rules
 func (t *TranslatorRules) load(files []string) (errors []error) {
 
 	for _, file := range files {
-		_, statErr := os.Stat(file)
-		if statErr == nil {
-			contents, readErr := ioutil.ReadFile(file)
+		rules, err := loadRulesFile(catalogFile{fsys: os.DirFS(filepath.Dir(file)), name: filepath.Base(file)})
+		if err != nil {
+			errors = append(errors, err)
+		}
 
-			if readErr != nil {
-				errors = append(errors, translatorError{message: "can't open rules file: " + readErr.Error()})
-			}
+		if rules != nil {
+			t.merge(rules)
+		}
+	}
+
+	for _, err := range t.compile() {
+		errors = append(errors, err)
+	}
+
+	return
+}
 
-			tNew := new(TranslatorRules)
-			yamlErr := yaml.Unmarshal(contents, tNew)
+// loadRulesFile unmarshalls a single rules file. The file format is chosen by
+// the file's extension. The rules are nil if the file doesn't exist or can't
+// be unmarshalled.
+func loadRulesFile(file catalogFile) (rules *TranslatorRules, err error) {
+	_, statErr := fs.Stat(file.fsys, file.name)
+	if statErr != nil {
+		return
+	}
 
-			if yamlErr != nil {
-				errors = append(errors, translatorError{message: "can't load rules YAML: " + yamlErr.Error()})
-			} else {
-				t.merge(tNew)
-			}
+	contents, readErr := fs.ReadFile(file.fsys, file.name)
+	if readErr != nil {
+		err = translatorError{message: "can't open rules file: " + readErr.Error()}
+		return
+	}
+
+	rules = new(TranslatorRules)
+
+	if path.Ext(file.name) == ".json" {
+		jsonErr := json.Unmarshal(contents, rules)
+		if jsonErr != nil {
+			rules = nil
+			err = translatorError{message: "can't load rules JSON: " + jsonErr.Error()}
+		}
+	} else {
+		yamlErr := yaml.Unmarshal(contents, rules)
+		if yamlErr != nil {
+			rules = nil
+			err = translatorError{message: "can't load rules YAML: " + yamlErr.Error()}
 		}
 	}
 
-	// set the plural rule func
-	pRule, ok := pluralRules[t.Plural]
-	if ok {
-		t.PluralRuleFunc = pRule
+	return
+}
+
+// compile compiles the plural and ordinal rule funcs from the merged rules, and
+// validates the rest of the rules, replacing invalid rules with defaults
+func (t *TranslatorRules) compile() (errors []error) {
+
+	// compile the plural rule func - either from the CLDR plural rule
+	// conditions in the rules files, or from one of the named plural rules
+	var err error
+	if len(t.PluralRules) > 0 {
+		t.pluralOperandsFunc, t.PluralCategories, err = compilePluralRule(t.PluralRules)
+		if err != nil {
+			errors = append(errors, translatorError{message: "invalid plural rules: " + err.Error()})
+		}
+	} else if rules, ok := pluralRules[t.Plural]; ok {
+		t.pluralOperandsFunc, t.PluralCategories, err = compilePluralRule(rules)
+		if err != nil {
+			errors = append(errors, translatorError{message: "invalid plural rule: " + t.Plural + ": " + err.Error()})
+		}
+	} else if t.Plural == "" {
+		errors = append(errors, translatorError{message: "missing plural rule"})
 	} else {
-		if t.Plural == "" {
-			errors = append(errors, translatorError{message: "missing plural rule: " + t.Plural})
+		errors = append(errors, translatorError{message: "invalid plural rule: " + t.Plural})
+	}
 
-		} else {
-			errors = append(errors, translatorError{message: "invalid plural rule: " + t.Plural})
+	if t.pluralOperandsFunc == nil || err != nil {
+		t.pluralOperandsFunc, t.PluralCategories, _ = compilePluralRule(pluralRules["1"])
+	}
+	t.PluralRuleFunc = t.pluralOperandsFunc.pluralRule()
+
+	// compile the ordinal rule func - most languages don't have ordinal
+	// plurals, so a missing ordinal rule is not an error
+	err = nil
+	if len(t.OrdinalRules) > 0 {
+		t.ordinalOperandsFunc, t.OrdinalCategories, err = compilePluralRule(t.OrdinalRules)
+		if err != nil {
+			errors = append(errors, translatorError{message: "invalid ordinal rules: " + err.Error()})
+		}
+	} else if rules, ok := ordinalRules[t.Ordinal]; ok {
+		t.ordinalOperandsFunc, t.OrdinalCategories, err = compilePluralRule(rules)
+		if err != nil {
+			errors = append(errors, translatorError{message: "invalid ordinal rule: " + t.Ordinal + ": " + err.Error()})
+		}
+	} else if t.Ordinal != "" {
+		errors = append(errors, translatorError{message: "invalid ordinal rule: " + t.Ordinal})
+	}
+
+	if t.ordinalOperandsFunc == nil || err != nil {
+		t.ordinalOperandsFunc, t.OrdinalCategories, _ = compilePluralRule(ordinalRules["1"])
+	}
+	t.OrdinalRuleFunc = t.ordinalOperandsFunc.pluralRule()
+
+	// validate the plural ranges - a range not in the table uses the end
+	// category, so only invalid category names are an error
+	for start, ends := range t.PluralRanges {
+		for end, result := range ends {
+			_, startOk := pluralCategoryFromName(start)
+			_, endOk := pluralCategoryFromName(end)
+			_, resultOk := pluralCategoryFromName(result)
+			if !startOk || !endOk || !resultOk {
+				errors = append(errors, translatorError{message: "invalid plural range: " + start + "-" + end + ": " + result})
+				delete(ends, end)
+			}
 		}
-		t.PluralRuleFunc = pluralRules["1"]
 	}
 
 	if t.Direction == "" {
@@ -219,12 +568,48 @@ func (t *TranslatorRules) load(files []string) (errors []error) {
 // instance - as that doesn't do what we want for deep merging.
 func (t *TranslatorRules) merge(tNew *TranslatorRules) {
 
-	t.Plural = stringMerge(t.Plural, tNew.Plural)
+	// a named plural rule and plural rule conditions replace each other, so
+	// that the rules from the last file that sets either one are used
+	if tNew.Plural != "" {
+		t.Plural = tNew.Plural
+		t.PluralRules = nil
+	}
+
+	if len(tNew.PluralRules) > 0 {
+		t.Plural = ""
+		t.PluralRules = tNew.PluralRules
+	}
 
 	if tNew.PluralRuleFunc != nil {
 		t.PluralRuleFunc = tNew.PluralRuleFunc
 	}
 
+	if tNew.Ordinal != "" {
+		t.Ordinal = tNew.Ordinal
+		t.OrdinalRules = nil
+	}
+
+	if len(tNew.OrdinalRules) > 0 {
+		t.Ordinal = ""
+		t.OrdinalRules = tNew.OrdinalRules
+	}
+
+	if tNew.OrdinalRuleFunc != nil {
+		t.OrdinalRuleFunc = tNew.OrdinalRuleFunc
+	}
+
+	for start, ends := range tNew.PluralRanges {
+		if t.PluralRanges == nil {
+			t.PluralRanges = make(map[string]map[string]string)
+		}
+		if _, ok := t.PluralRanges[start]; !ok {
+			t.PluralRanges[start] = make(map[string]string)
+		}
+		for end, result := range ends {
+			t.PluralRanges[start][end] = stringMerge(t.PluralRanges[start][end], result)
+		}
+	}
+
 	t.Direction = stringMerge(t.Direction, tNew.Direction)
 
 	t.Numbers.Symbols.Decimal = stringMerge(t.Numbers.Symbols.Decimal, tNew.Numbers.Symbols.Decimal)
@@ -240,8 +625,9 @@ func (t *TranslatorRules) merge(tNew *TranslatorRules) {
 
 	for i, c := range tNew.Currencies {
 		if t.Currencies == nil {
-			t.Currencies = tNew.Currencies
-		} else if _, ok := t.Currencies[i]; !ok {
+			t.Currencies = make(map[string]currency)
+		}
+		if _, ok := t.Currencies[i]; !ok {
 			t.Currencies[i] = c
 		} else {
 			tmp := t.Currencies[i]
@@ -265,6 +651,10 @@ func (t *TranslatorRules) merge(tNew *TranslatorRules) {
 	t.DateTime.Formats.DateTime.Medium = stringMerge(t.DateTime.Formats.DateTime.Medium, tNew.DateTime.Formats.DateTime.Medium)
 	t.DateTime.Formats.DateTime.Short = stringMerge(t.DateTime.Formats.DateTime.Short, tNew.DateTime.Formats.DateTime.Short)
 
+	t.DateTime.AvailableFormats = mergeStringMaps(t.DateTime.AvailableFormats, tNew.DateTime.AvailableFormats)
+	t.DateTime.AppendItems = mergeStringMaps(t.DateTime.AppendItems, tNew.DateTime.AppendItems)
+	t.DateTime.FieldNames = mergeStringMaps(t.DateTime.FieldNames, tNew.DateTime.FieldNames)
+
 	t.DateTime.FormatNames.Months.Abbreviated.Month1 = stringMerge(t.DateTime.FormatNames.Months.Abbreviated.Month1, tNew.DateTime.FormatNames.Months.Abbreviated.Month1)
 	t.DateTime.FormatNames.Months.Abbreviated.Month2 = stringMerge(t.DateTime.FormatNames.Months.Abbreviated.Month2, tNew.DateTime.FormatNames.Months.Abbreviated.Month2)
 	t.DateTime.FormatNames.Months.Abbreviated.Month3 = stringMerge(t.DateTime.FormatNames.Months.Abbreviated.Month3, tNew.DateTime.FormatNames.Months.Abbreviated.Month3)
@@ -338,10 +728,195 @@ func (t *TranslatorRules) merge(tNew *TranslatorRules) {
 
 	t.DateTime.FormatNames.Periods.Abbreviated.AM = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.AM, tNew.DateTime.FormatNames.Periods.Abbreviated.AM)
 	t.DateTime.FormatNames.Periods.Abbreviated.PM = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.PM, tNew.DateTime.FormatNames.Periods.Abbreviated.PM)
+	t.DateTime.FormatNames.Periods.Abbreviated.Midnight = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Midnight, tNew.DateTime.FormatNames.Periods.Abbreviated.Midnight)
+	t.DateTime.FormatNames.Periods.Abbreviated.Noon = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Noon, tNew.DateTime.FormatNames.Periods.Abbreviated.Noon)
+	t.DateTime.FormatNames.Periods.Abbreviated.Morning1 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Morning1, tNew.DateTime.FormatNames.Periods.Abbreviated.Morning1)
+	t.DateTime.FormatNames.Periods.Abbreviated.Morning2 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Morning2, tNew.DateTime.FormatNames.Periods.Abbreviated.Morning2)
+	t.DateTime.FormatNames.Periods.Abbreviated.Afternoon1 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Afternoon1, tNew.DateTime.FormatNames.Periods.Abbreviated.Afternoon1)
+	t.DateTime.FormatNames.Periods.Abbreviated.Afternoon2 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Afternoon2, tNew.DateTime.FormatNames.Periods.Abbreviated.Afternoon2)
+	t.DateTime.FormatNames.Periods.Abbreviated.Evening1 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Evening1, tNew.DateTime.FormatNames.Periods.Abbreviated.Evening1)
+	t.DateTime.FormatNames.Periods.Abbreviated.Evening2 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Evening2, tNew.DateTime.FormatNames.Periods.Abbreviated.Evening2)
+	t.DateTime.FormatNames.Periods.Abbreviated.Night1 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Night1, tNew.DateTime.FormatNames.Periods.Abbreviated.Night1)
+	t.DateTime.FormatNames.Periods.Abbreviated.Night2 = stringMerge(t.DateTime.FormatNames.Periods.Abbreviated.Night2, tNew.DateTime.FormatNames.Periods.Abbreviated.Night2)
 	t.DateTime.FormatNames.Periods.Narrow.AM = stringMerge(t.DateTime.FormatNames.Periods.Narrow.AM, tNew.DateTime.FormatNames.Periods.Narrow.AM)
 	t.DateTime.FormatNames.Periods.Narrow.PM = stringMerge(t.DateTime.FormatNames.Periods.Narrow.PM, tNew.DateTime.FormatNames.Periods.Narrow.PM)
+	t.DateTime.FormatNames.Periods.Narrow.Midnight = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Midnight, tNew.DateTime.FormatNames.Periods.Narrow.Midnight)
+	t.DateTime.FormatNames.Periods.Narrow.Noon = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Noon, tNew.DateTime.FormatNames.Periods.Narrow.Noon)
+	t.DateTime.FormatNames.Periods.Narrow.Morning1 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Morning1, tNew.DateTime.FormatNames.Periods.Narrow.Morning1)
+	t.DateTime.FormatNames.Periods.Narrow.Morning2 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Morning2, tNew.DateTime.FormatNames.Periods.Narrow.Morning2)
+	t.DateTime.FormatNames.Periods.Narrow.Afternoon1 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Afternoon1, tNew.DateTime.FormatNames.Periods.Narrow.Afternoon1)
+	t.DateTime.FormatNames.Periods.Narrow.Afternoon2 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Afternoon2, tNew.DateTime.FormatNames.Periods.Narrow.Afternoon2)
+	t.DateTime.FormatNames.Periods.Narrow.Evening1 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Evening1, tNew.DateTime.FormatNames.Periods.Narrow.Evening1)
+	t.DateTime.FormatNames.Periods.Narrow.Evening2 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Evening2, tNew.DateTime.FormatNames.Periods.Narrow.Evening2)
+	t.DateTime.FormatNames.Periods.Narrow.Night1 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Night1, tNew.DateTime.FormatNames.Periods.Narrow.Night1)
+	t.DateTime.FormatNames.Periods.Narrow.Night2 = stringMerge(t.DateTime.FormatNames.Periods.Narrow.Night2, tNew.DateTime.FormatNames.Periods.Narrow.Night2)
 	t.DateTime.FormatNames.Periods.Wide.AM = stringMerge(t.DateTime.FormatNames.Periods.Wide.AM, tNew.DateTime.FormatNames.Periods.Wide.AM)
 	t.DateTime.FormatNames.Periods.Wide.PM = stringMerge(t.DateTime.FormatNames.Periods.Wide.PM, tNew.DateTime.FormatNames.Periods.Wide.PM)
+	t.DateTime.FormatNames.Periods.Wide.Midnight = stringMerge(t.DateTime.FormatNames.Periods.Wide.Midnight, tNew.DateTime.FormatNames.Periods.Wide.Midnight)
+	t.DateTime.FormatNames.Periods.Wide.Noon = stringMerge(t.DateTime.FormatNames.Periods.Wide.Noon, tNew.DateTime.FormatNames.Periods.Wide.Noon)
+	t.DateTime.FormatNames.Periods.Wide.Morning1 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Morning1, tNew.DateTime.FormatNames.Periods.Wide.Morning1)
+	t.DateTime.FormatNames.Periods.Wide.Morning2 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Morning2, tNew.DateTime.FormatNames.Periods.Wide.Morning2)
+	t.DateTime.FormatNames.Periods.Wide.Afternoon1 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Afternoon1, tNew.DateTime.FormatNames.Periods.Wide.Afternoon1)
+	t.DateTime.FormatNames.Periods.Wide.Afternoon2 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Afternoon2, tNew.DateTime.FormatNames.Periods.Wide.Afternoon2)
+	t.DateTime.FormatNames.Periods.Wide.Evening1 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Evening1, tNew.DateTime.FormatNames.Periods.Wide.Evening1)
+	t.DateTime.FormatNames.Periods.Wide.Evening2 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Evening2, tNew.DateTime.FormatNames.Periods.Wide.Evening2)
+	t.DateTime.FormatNames.Periods.Wide.Night1 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Night1, tNew.DateTime.FormatNames.Periods.Wide.Night1)
+	t.DateTime.FormatNames.Periods.Wide.Night2 = stringMerge(t.DateTime.FormatNames.Periods.Wide.Night2, tNew.DateTime.FormatNames.Periods.Wide.Night2)
+
+	t.DateTime.FormatNames.Eras.Abbreviated.BC = stringMerge(t.DateTime.FormatNames.Eras.Abbreviated.BC, tNew.DateTime.FormatNames.Eras.Abbreviated.BC)
+	t.DateTime.FormatNames.Eras.Abbreviated.AD = stringMerge(t.DateTime.FormatNames.Eras.Abbreviated.AD, tNew.DateTime.FormatNames.Eras.Abbreviated.AD)
+
+	t.DateTime.FormatNames.Eras.Narrow.BC = stringMerge(t.DateTime.FormatNames.Eras.Narrow.BC, tNew.DateTime.FormatNames.Eras.Narrow.BC)
+	t.DateTime.FormatNames.Eras.Narrow.AD = stringMerge(t.DateTime.FormatNames.Eras.Narrow.AD, tNew.DateTime.FormatNames.Eras.Narrow.AD)
+
+	t.DateTime.FormatNames.Eras.Wide.BC = stringMerge(t.DateTime.FormatNames.Eras.Wide.BC, tNew.DateTime.FormatNames.Eras.Wide.BC)
+	t.DateTime.FormatNames.Eras.Wide.AD = stringMerge(t.DateTime.FormatNames.Eras.Wide.AD, tNew.DateTime.FormatNames.Eras.Wide.AD)
+
+	t.DateTime.FormatNames.Quarters.Abbreviated.Quarter1 = stringMerge(t.DateTime.FormatNames.Quarters.Abbreviated.Quarter1, tNew.DateTime.FormatNames.Quarters.Abbreviated.Quarter1)
+	t.DateTime.FormatNames.Quarters.Abbreviated.Quarter2 = stringMerge(t.DateTime.FormatNames.Quarters.Abbreviated.Quarter2, tNew.DateTime.FormatNames.Quarters.Abbreviated.Quarter2)
+	t.DateTime.FormatNames.Quarters.Abbreviated.Quarter3 = stringMerge(t.DateTime.FormatNames.Quarters.Abbreviated.Quarter3, tNew.DateTime.FormatNames.Quarters.Abbreviated.Quarter3)
+	t.DateTime.FormatNames.Quarters.Abbreviated.Quarter4 = stringMerge(t.DateTime.FormatNames.Quarters.Abbreviated.Quarter4, tNew.DateTime.FormatNames.Quarters.Abbreviated.Quarter4)
+
+	t.DateTime.FormatNames.Quarters.Narrow.Quarter1 = stringMerge(t.DateTime.FormatNames.Quarters.Narrow.Quarter1, tNew.DateTime.FormatNames.Quarters.Narrow.Quarter1)
+	t.DateTime.FormatNames.Quarters.Narrow.Quarter2 = stringMerge(t.DateTime.FormatNames.Quarters.Narrow.Quarter2, tNew.DateTime.FormatNames.Quarters.Narrow.Quarter2)
+	t.DateTime.FormatNames.Quarters.Narrow.Quarter3 = stringMerge(t.DateTime.FormatNames.Quarters.Narrow.Quarter3, tNew.DateTime.FormatNames.Quarters.Narrow.Quarter3)
+	t.DateTime.FormatNames.Quarters.Narrow.Quarter4 = stringMerge(t.DateTime.FormatNames.Quarters.Narrow.Quarter4, tNew.DateTime.FormatNames.Quarters.Narrow.Quarter4)
+
+	t.DateTime.FormatNames.Quarters.Wide.Quarter1 = stringMerge(t.DateTime.FormatNames.Quarters.Wide.Quarter1, tNew.DateTime.FormatNames.Quarters.Wide.Quarter1)
+	t.DateTime.FormatNames.Quarters.Wide.Quarter2 = stringMerge(t.DateTime.FormatNames.Quarters.Wide.Quarter2, tNew.DateTime.FormatNames.Quarters.Wide.Quarter2)
+	t.DateTime.FormatNames.Quarters.Wide.Quarter3 = stringMerge(t.DateTime.FormatNames.Quarters.Wide.Quarter3, tNew.DateTime.FormatNames.Quarters.Wide.Quarter3)
+	t.DateTime.FormatNames.Quarters.Wide.Quarter4 = stringMerge(t.DateTime.FormatNames.Quarters.Wide.Quarter4, tNew.DateTime.FormatNames.Quarters.Wide.Quarter4)
+
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month1 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month1, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month1)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month2 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month2, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month2)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month3 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month3, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month3)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month4 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month4, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month4)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month5 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month5, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month5)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month6 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month6, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month6)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month7 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month7, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month7)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month8 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month8, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month8)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month9 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month9, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month9)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month10 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month10, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month10)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month11 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month11, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month11)
+	t.DateTime.StandAloneNames.Months.Abbreviated.Month12 = stringMerge(t.DateTime.StandAloneNames.Months.Abbreviated.Month12, tNew.DateTime.StandAloneNames.Months.Abbreviated.Month12)
+
+	t.DateTime.StandAloneNames.Months.Narrow.Month1 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month1, tNew.DateTime.StandAloneNames.Months.Narrow.Month1)
+	t.DateTime.StandAloneNames.Months.Narrow.Month2 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month2, tNew.DateTime.StandAloneNames.Months.Narrow.Month2)
+	t.DateTime.StandAloneNames.Months.Narrow.Month3 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month3, tNew.DateTime.StandAloneNames.Months.Narrow.Month3)
+	t.DateTime.StandAloneNames.Months.Narrow.Month4 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month4, tNew.DateTime.StandAloneNames.Months.Narrow.Month4)
+	t.DateTime.StandAloneNames.Months.Narrow.Month5 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month5, tNew.DateTime.StandAloneNames.Months.Narrow.Month5)
+	t.DateTime.StandAloneNames.Months.Narrow.Month6 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month6, tNew.DateTime.StandAloneNames.Months.Narrow.Month6)
+	t.DateTime.StandAloneNames.Months.Narrow.Month7 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month7, tNew.DateTime.StandAloneNames.Months.Narrow.Month7)
+	t.DateTime.StandAloneNames.Months.Narrow.Month8 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month8, tNew.DateTime.StandAloneNames.Months.Narrow.Month8)
+	t.DateTime.StandAloneNames.Months.Narrow.Month9 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month9, tNew.DateTime.StandAloneNames.Months.Narrow.Month9)
+	t.DateTime.StandAloneNames.Months.Narrow.Month10 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month10, tNew.DateTime.StandAloneNames.Months.Narrow.Month10)
+	t.DateTime.StandAloneNames.Months.Narrow.Month11 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month11, tNew.DateTime.StandAloneNames.Months.Narrow.Month11)
+	t.DateTime.StandAloneNames.Months.Narrow.Month12 = stringMerge(t.DateTime.StandAloneNames.Months.Narrow.Month12, tNew.DateTime.StandAloneNames.Months.Narrow.Month12)
+
+	t.DateTime.StandAloneNames.Months.Wide.Month1 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month1, tNew.DateTime.StandAloneNames.Months.Wide.Month1)
+	t.DateTime.StandAloneNames.Months.Wide.Month2 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month2, tNew.DateTime.StandAloneNames.Months.Wide.Month2)
+	t.DateTime.StandAloneNames.Months.Wide.Month3 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month3, tNew.DateTime.StandAloneNames.Months.Wide.Month3)
+	t.DateTime.StandAloneNames.Months.Wide.Month4 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month4, tNew.DateTime.StandAloneNames.Months.Wide.Month4)
+	t.DateTime.StandAloneNames.Months.Wide.Month5 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month5, tNew.DateTime.StandAloneNames.Months.Wide.Month5)
+	t.DateTime.StandAloneNames.Months.Wide.Month6 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month6, tNew.DateTime.StandAloneNames.Months.Wide.Month6)
+	t.DateTime.StandAloneNames.Months.Wide.Month7 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month7, tNew.DateTime.StandAloneNames.Months.Wide.Month7)
+	t.DateTime.StandAloneNames.Months.Wide.Month8 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month8, tNew.DateTime.StandAloneNames.Months.Wide.Month8)
+	t.DateTime.StandAloneNames.Months.Wide.Month9 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month9, tNew.DateTime.StandAloneNames.Months.Wide.Month9)
+	t.DateTime.StandAloneNames.Months.Wide.Month10 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month10, tNew.DateTime.StandAloneNames.Months.Wide.Month10)
+	t.DateTime.StandAloneNames.Months.Wide.Month11 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month11, tNew.DateTime.StandAloneNames.Months.Wide.Month11)
+	t.DateTime.StandAloneNames.Months.Wide.Month12 = stringMerge(t.DateTime.StandAloneNames.Months.Wide.Month12, tNew.DateTime.StandAloneNames.Months.Wide.Month12)
+
+	t.DateTime.StandAloneNames.Days.Abbreviated.Sun = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Sun, tNew.DateTime.StandAloneNames.Days.Abbreviated.Sun)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Mon = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Mon, tNew.DateTime.StandAloneNames.Days.Abbreviated.Mon)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Tue = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Tue, tNew.DateTime.StandAloneNames.Days.Abbreviated.Tue)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Wed = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Wed, tNew.DateTime.StandAloneNames.Days.Abbreviated.Wed)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Thu = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Thu, tNew.DateTime.StandAloneNames.Days.Abbreviated.Thu)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Fri = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Fri, tNew.DateTime.StandAloneNames.Days.Abbreviated.Fri)
+	t.DateTime.StandAloneNames.Days.Abbreviated.Sat = stringMerge(t.DateTime.StandAloneNames.Days.Abbreviated.Sat, tNew.DateTime.StandAloneNames.Days.Abbreviated.Sat)
+
+	t.DateTime.StandAloneNames.Days.Narrow.Sun = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Sun, tNew.DateTime.StandAloneNames.Days.Narrow.Sun)
+	t.DateTime.StandAloneNames.Days.Narrow.Mon = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Mon, tNew.DateTime.StandAloneNames.Days.Narrow.Mon)
+	t.DateTime.StandAloneNames.Days.Narrow.Tue = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Tue, tNew.DateTime.StandAloneNames.Days.Narrow.Tue)
+	t.DateTime.StandAloneNames.Days.Narrow.Wed = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Wed, tNew.DateTime.StandAloneNames.Days.Narrow.Wed)
+	t.DateTime.StandAloneNames.Days.Narrow.Thu = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Thu, tNew.DateTime.StandAloneNames.Days.Narrow.Thu)
+	t.DateTime.StandAloneNames.Days.Narrow.Fri = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Fri, tNew.DateTime.StandAloneNames.Days.Narrow.Fri)
+	t.DateTime.StandAloneNames.Days.Narrow.Sat = stringMerge(t.DateTime.StandAloneNames.Days.Narrow.Sat, tNew.DateTime.StandAloneNames.Days.Narrow.Sat)
+
+	t.DateTime.StandAloneNames.Days.Short.Sun = stringMerge(t.DateTime.StandAloneNames.Days.Short.Sun, tNew.DateTime.StandAloneNames.Days.Short.Sun)
+	t.DateTime.StandAloneNames.Days.Short.Mon = stringMerge(t.DateTime.StandAloneNames.Days.Short.Mon, tNew.DateTime.StandAloneNames.Days.Short.Mon)
+	t.DateTime.StandAloneNames.Days.Short.Tue = stringMerge(t.DateTime.StandAloneNames.Days.Short.Tue, tNew.DateTime.StandAloneNames.Days.Short.Tue)
+	t.DateTime.StandAloneNames.Days.Short.Wed = stringMerge(t.DateTime.StandAloneNames.Days.Short.Wed, tNew.DateTime.StandAloneNames.Days.Short.Wed)
+	t.DateTime.StandAloneNames.Days.Short.Thu = stringMerge(t.DateTime.StandAloneNames.Days.Short.Thu, tNew.DateTime.StandAloneNames.Days.Short.Thu)
+	t.DateTime.StandAloneNames.Days.Short.Fri = stringMerge(t.DateTime.StandAloneNames.Days.Short.Fri, tNew.DateTime.StandAloneNames.Days.Short.Fri)
+	t.DateTime.StandAloneNames.Days.Short.Sat = stringMerge(t.DateTime.StandAloneNames.Days.Short.Sat, tNew.DateTime.StandAloneNames.Days.Short.Sat)
+
+	t.DateTime.StandAloneNames.Days.Wide.Sun = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Sun, tNew.DateTime.StandAloneNames.Days.Wide.Sun)
+	t.DateTime.StandAloneNames.Days.Wide.Mon = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Mon, tNew.DateTime.StandAloneNames.Days.Wide.Mon)
+	t.DateTime.StandAloneNames.Days.Wide.Tue = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Tue, tNew.DateTime.StandAloneNames.Days.Wide.Tue)
+	t.DateTime.StandAloneNames.Days.Wide.Wed = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Wed, tNew.DateTime.StandAloneNames.Days.Wide.Wed)
+	t.DateTime.StandAloneNames.Days.Wide.Thu = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Thu, tNew.DateTime.StandAloneNames.Days.Wide.Thu)
+	t.DateTime.StandAloneNames.Days.Wide.Fri = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Fri, tNew.DateTime.StandAloneNames.Days.Wide.Fri)
+	t.DateTime.StandAloneNames.Days.Wide.Sat = stringMerge(t.DateTime.StandAloneNames.Days.Wide.Sat, tNew.DateTime.StandAloneNames.Days.Wide.Sat)
+
+	t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter1 = stringMerge(t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter1, tNew.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter1)
+	t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter2 = stringMerge(t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter2, tNew.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter2)
+	t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter3 = stringMerge(t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter3, tNew.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter3)
+	t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter4 = stringMerge(t.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter4, tNew.DateTime.StandAloneNames.Quarters.Abbreviated.Quarter4)
+
+	t.DateTime.StandAloneNames.Quarters.Narrow.Quarter1 = stringMerge(t.DateTime.StandAloneNames.Quarters.Narrow.Quarter1, tNew.DateTime.StandAloneNames.Quarters.Narrow.Quarter1)
+	t.DateTime.StandAloneNames.Quarters.Narrow.Quarter2 = stringMerge(t.DateTime.StandAloneNames.Quarters.Narrow.Quarter2, tNew.DateTime.StandAloneNames.Quarters.Narrow.Quarter2)
+	t.DateTime.StandAloneNames.Quarters.Narrow.Quarter3 = stringMerge(t.DateTime.StandAloneNames.Quarters.Narrow.Quarter3, tNew.DateTime.StandAloneNames.Quarters.Narrow.Quarter3)
+	t.DateTime.StandAloneNames.Quarters.Narrow.Quarter4 = stringMerge(t.DateTime.StandAloneNames.Quarters.Narrow.Quarter4, tNew.DateTime.StandAloneNames.Quarters.Narrow.Quarter4)
+
+	t.DateTime.StandAloneNames.Quarters.Wide.Quarter1 = stringMerge(t.DateTime.StandAloneNames.Quarters.Wide.Quarter1, tNew.DateTime.StandAloneNames.Quarters.Wide.Quarter1)
+	t.DateTime.StandAloneNames.Quarters.Wide.Quarter2 = stringMerge(t.DateTime.StandAloneNames.Quarters.Wide.Quarter2, tNew.DateTime.StandAloneNames.Quarters.Wide.Quarter2)
+	t.DateTime.StandAloneNames.Quarters.Wide.Quarter3 = stringMerge(t.DateTime.StandAloneNames.Quarters.Wide.Quarter3, tNew.DateTime.StandAloneNames.Quarters.Wide.Quarter3)
+	t.DateTime.StandAloneNames.Quarters.Wide.Quarter4 = stringMerge(t.DateTime.StandAloneNames.Quarters.Wide.Quarter4, tNew.DateTime.StandAloneNames.Quarters.Wide.Quarter4)
+
+	t.DateTime.Week.FirstDay = stringMerge(t.DateTime.Week.FirstDay, tNew.DateTime.Week.FirstDay)
+	if tNew.DateTime.Week.MinDays != 0 {
+		t.DateTime.Week.MinDays = tNew.DateTime.Week.MinDays
+	}
+
+	// day period rules are replaced as a set, since a locale's periods only
+	// make sense together
+	if len(tNew.DateTime.DayPeriodRules) > 0 {
+		t.DateTime.DayPeriodRules = tNew.DateTime.DayPeriodRules
+	}
+
+	t.DateTime.TimeZoneNames.HourFormat = stringMerge(t.DateTime.TimeZoneNames.HourFormat, tNew.DateTime.TimeZoneNames.HourFormat)
+	t.DateTime.TimeZoneNames.GMTFormat = stringMerge(t.DateTime.TimeZoneNames.GMTFormat, tNew.DateTime.TimeZoneNames.GMTFormat)
+	t.DateTime.TimeZoneNames.GMTZeroFormat = stringMerge(t.DateTime.TimeZoneNames.GMTZeroFormat, tNew.DateTime.TimeZoneNames.GMTZeroFormat)
+	t.DateTime.TimeZoneNames.RegionFormat = stringMerge(t.DateTime.TimeZoneNames.RegionFormat, tNew.DateTime.TimeZoneNames.RegionFormat)
+	t.DateTime.TimeZoneNames.Zones = mergeTimeZoneNames(t.DateTime.TimeZoneNames.Zones, tNew.DateTime.TimeZoneNames.Zones)
+	t.DateTime.TimeZoneNames.Metazones = mergeTimeZoneNames(t.DateTime.TimeZoneNames.Metazones, tNew.DateTime.TimeZoneNames.Metazones)
+}
+
+// pluralOperands returns the plural operands for a number. If numberStr, the
+// number as it's displayed, is the same number formatted with the locale's
+// decimal and group symbols, the operands come from numberStr so that its
+// visible fraction digits - like the 0 in "1.0" - are used. Otherwise, they
+// come from the number float64.
+func (t *TranslatorRules) pluralOperands(number float64, numberStr string) *pluralOperands {
+	o, ok := parsePluralOperands(numberStr, t.Numbers.Symbols.Decimal, t.Numbers.Symbols.Group)
+	if !ok || o.n != math.Abs(number) {
+		return newPluralOperands(number)
+	}
+
+	return o
+}
+
+// pluralRange returns the plural category to use for a range of numbers, given
+// the plural categories of the start and end of the range. Ranges missing from
+// the locale's pluralRanges table use the category of the end of the range.
+func (t *TranslatorRules) pluralRange(start, end pluralCategory) pluralCategory {
+	if result, ok := t.PluralRanges[start.String()][end.String()]; ok {
+		if category, ok := pluralCategoryFromName(result); ok {
+			return category
+		}
+	}
+
+	return end
 }
 
 func stringMerge(str1, str2 string) string {
@@ -1,7 +1,8 @@
 package i18n
 
 import (
-	"reflect"
+	"io/ioutil"
+	"os"
 
 	. "gopkg.in/check.v1"
 )
@@ -26,7 +27,44 @@ func (s *MySuite) TestLoad(c *C) {
 	c.Check(t.Numbers.Formats.Decimal, Equals, "#,##0.###")
 	c.Check(t.Numbers.Formats.Percent, Equals, "#,##0%")
 	c.Check(t.Plural, Equals, "2A")
-	c.Check(funcEquals(t.PluralRuleFunc, pluralRule2A), Equals, true)
+	c.Check(t.PluralRuleFunc(1), Equals, pluralCategoryOne)
+	c.Check(t.PluralRuleFunc(2), Equals, pluralCategoryOther)
+	c.Check(t.PluralCategories, DeepEquals, []pluralCategory{pluralCategoryOne, pluralCategoryOther})
+	c.Check(t.Ordinal, Equals, "")
+	c.Check(t.OrdinalRuleFunc(1), Equals, pluralCategoryOther)
+
+	t = new(TranslatorRules)
+	errs = t.load([]string{"data/rules/root.yaml", "data/rules/en.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.Ordinal, Equals, "4A")
+	c.Check(t.OrdinalRuleFunc(22), Equals, pluralCategoryTwo)
+	c.Check(t.OrdinalCategories, HasLen, 4)
+	c.Check(t.DateTime.FormatNames.Eras.Wide.AD, Equals, "Anno Domini")
+	c.Check(t.DateTime.FormatNames.Eras.Abbreviated.BC, Equals, "BC")
+	c.Check(t.DateTime.FormatNames.Quarters.Abbreviated.Quarter1, Equals, "Q1")
+	c.Check(t.DateTime.FormatNames.Quarters.Wide.Quarter4, Equals, "4th quarter")
+	c.Check(t.DateTime.FormatNames.Periods.Wide.Noon, Equals, "noon")
+	c.Check(t.DateTime.Week.FirstDay, Equals, "sun")
+	c.Check(t.DateTime.Week.MinDays, Equals, 1)
+	c.Check(t.DateTime.DayPeriodRules["evening1"], Equals, "18:00-21:00")
+	c.Check(t.DateTime.AppendItems["Week"], Equals, "{0} ({2}: {1})")
+	c.Check(t.DateTime.FieldNames["Week"], Equals, "week")
+
+	t = new(TranslatorRules)
+	errs = t.load([]string{"data/rules/root.yaml", "data/rules/en.yaml", "data/rules/en-gb.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.DateTime.Week.FirstDay, Equals, "mon")
+	c.Check(t.DateTime.Week.MinDays, Equals, 4)
+	c.Check(t.DateTime.DayPeriodRules["evening1"], Equals, "18:00-21:00")
+	c.Check(t.DateTime.AvailableFormats["yMMMd"], Equals, "d MMM y")
+	c.Check(t.DateTime.AvailableFormats["yMMMM"], Equals, "MMMM y")
+	c.Check(t.DateTime.AvailableFormats["y"], Equals, "y")
+
+	t = new(TranslatorRules)
+	errs = t.load([]string{"data/rules/root.yaml", "data/rules/en.yaml", s.rulesDir + "/en.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.Ordinal, Equals, "4A")
+	c.Check(t.PluralRanges["one"]["other"], Equals, "other")
 
 	// basic check for all complete locales
 	locales := []string{
@@ -73,6 +111,114 @@ func (s *MySuite) TestLoad(c *C) {
 	}
 }
 
-func funcEquals(f1 func(float64) int, f2 func(float64) int) bool {
-	return reflect.ValueOf(f1) == reflect.ValueOf(f2)
+func (s *MySuite) TestPluralRange(c *C) {
+	t := new(TranslatorRules)
+	errs := t.load([]string{"data/rules/root.yaml", "data/rules/ar.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.pluralRange(pluralCategoryZero, pluralCategoryOne), Equals, pluralCategoryZero)
+	c.Check(t.pluralRange(pluralCategoryOne, pluralCategoryTwo), Equals, pluralCategoryOther)
+	c.Check(t.pluralRange(pluralCategoryTwo, pluralCategoryFew), Equals, pluralCategoryFew)
+
+	// ranges not in the table use the end category
+	t = new(TranslatorRules)
+	errs = t.load([]string{"data/rules/root.yaml", "data/rules/de.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.pluralRange(pluralCategoryOther, pluralCategoryOne), Equals, pluralCategoryOne)
+	c.Check(t.pluralRange(pluralCategoryOne, pluralCategoryOther), Equals, pluralCategoryOther)
+
+	// invalid categories
+	t = new(TranslatorRules)
+	t.Plural = "2A"
+	t.Direction = "LTR"
+	t.PluralRanges = map[string]map[string]string{"one": {"other": "lots"}}
+	errs = t.load([]string{})
+	c.Check(errs, HasLen, 1)
+	c.Check(t.pluralRange(pluralCategoryOne, pluralCategoryOther), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestLoadJSON(c *C) {
+	dir := c.MkDir()
+
+	enRules := `{
+		"direction": "LTR",
+		"plural": "2A",
+		"ordinal": "4A",
+		"numbers": {"symbols": {"decimal": ",", "group": "."}},
+		"currencies": {"USD": {"symbol": "US$"}}
+	}`
+	err := ioutil.WriteFile(dir+"/en.json", []byte(enRules), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	t := new(TranslatorRules)
+	errs := t.load([]string{"data/rules/root.yaml", "data/rules/en.yaml", dir + "/en.json"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.Plural, Equals, "2A")
+	c.Check(t.Ordinal, Equals, "4A")
+	c.Check(t.Numbers.Symbols.Decimal, Equals, ",")
+	c.Check(t.Numbers.Symbols.Group, Equals, ".")
+	c.Check(t.Numbers.Formats.Decimal, Equals, "#,##0.###")
+	c.Check(t.Currencies["USD"].Symbol, Equals, "US$")
+	c.Check(t.PluralRuleFunc(1), Equals, pluralCategoryOne)
+
+	err = ioutil.WriteFile(dir+"/xx.json", []byte(`{"plural": 2`), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	t = new(TranslatorRules)
+	errs = t.load([]string{"data/rules/root.yaml", dir + "/xx.json"})
+	c.Check(errs, HasLen, 3)
+}
+
+func (s *MySuite) TestLoadPluralRules(c *C) {
+	dir := c.MkDir()
+
+	ruRules := `
+pluralRules:
+  one: v = 0 and i % 10 = 1 and i % 100 != 11
+  few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14
+  many: v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14
+ordinalRules:
+  one: n = 1
+`
+	err := ioutil.WriteFile(dir+"/ru.yaml", []byte(ruRules), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	t := new(TranslatorRules)
+	errs := t.load([]string{"data/rules/root.yaml", "data/rules/ru.yaml", dir + "/ru.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.Plural, Equals, "")
+	c.Check(t.PluralCategories, DeepEquals, []pluralCategory{pluralCategoryOne, pluralCategoryFew, pluralCategoryMany, pluralCategoryOther})
+	c.Check(t.PluralRuleFunc(1), Equals, pluralCategoryOne)
+	c.Check(t.PluralRuleFunc(3), Equals, pluralCategoryFew)
+	c.Check(t.PluralRuleFunc(11), Equals, pluralCategoryMany)
+	c.Check(t.PluralRuleFunc(1.5), Equals, pluralCategoryOther)
+	c.Check(t.OrdinalCategories, DeepEquals, []pluralCategory{pluralCategoryOne, pluralCategoryOther})
+
+	// a named rule in a later file replaces the conditions
+	t = new(TranslatorRules)
+	errs = t.load([]string{dir + "/ru.yaml", "data/rules/ru.yaml"})
+	c.Check(errs, HasLen, 0)
+	c.Check(t.PluralRules, HasLen, 0)
+	c.Check(t.PluralCategories, HasLen, len(pluralRules[t.Plural])+1)
+
+	// invalid conditions fall back to the "1" rule
+	err = ioutil.WriteFile(dir+"/xx.yaml", []byte("direction: LTR\npluralRules:\n  one: n is is 1\n"), os.FileMode(0777))
+	c.Assert(err, IsNil)
+
+	t = new(TranslatorRules)
+	errs = t.load([]string{dir + "/xx.yaml"})
+	c.Check(errs, HasLen, 1)
+	c.Check(t.PluralCategories, DeepEquals, []pluralCategory{pluralCategoryOther})
+	c.Check(t.PluralRuleFunc(1), Equals, pluralCategoryOther)
+}
+
+func (s *MySuite) TestMergeStringMaps(c *C) {
+	values := map[string]string{"yMd": "y-MM-dd", "Hm": "HH:mm"}
+	newValues := map[string]string{"yMd": "M/d/y", "hm": "h:mm a"}
+
+	merged := mergeStringMaps(values, newValues)
+	c.Check(merged, DeepEquals, map[string]string{"yMd": "M/d/y", "Hm": "HH:mm", "hm": "h:mm a"})
+
+	// the maps being merged aren't changed
+	c.Check(values, DeepEquals, map[string]string{"yMd": "y-MM-dd", "Hm": "HH:mm"})
+	c.Check(mergeStringMaps(values, nil), DeepEquals, values)
 }
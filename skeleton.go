@@ -0,0 +1,529 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// The types of datetime fields a skeleton can ask for. Symbols with the same
+// type, like "M" and "L" or "h" and "H", show the same part of a datetime in
+// different ways.
+const (
+	skeletonFieldEra = iota
+	skeletonFieldYear
+	skeletonFieldQuarter
+	skeletonFieldMonth
+	skeletonFieldWeek
+	skeletonFieldDay
+	skeletonFieldDayOfWeek
+	skeletonFieldPeriod
+	skeletonFieldHour
+	skeletonFieldMinute
+	skeletonFieldSecond
+	skeletonFieldFractionalSecond
+	skeletonFieldTimeZone
+)
+
+// The penalties for the differences between a requested skeleton field and a
+// locale's available format field. A numeric field asked for as text, or the
+// other way around, is worse than a different symbol, like "H" for "h", which
+// is worse than any difference in length.
+const (
+	skeletonDistanceNumericText = 0x1000
+	skeletonDistanceSymbol      = 0x100
+)
+
+// skeletonFieldNames maps field types to their names in the locale's
+// appendItems and fieldNames rules. Day periods and fractional seconds aren't
+// appended on their own.
+var skeletonFieldNames = map[int]string{
+	skeletonFieldEra:       "Era",
+	skeletonFieldYear:      "Year",
+	skeletonFieldQuarter:   "Quarter",
+	skeletonFieldMonth:     "Month",
+	skeletonFieldWeek:      "Week",
+	skeletonFieldDay:       "Day",
+	skeletonFieldDayOfWeek: "Day-Of-Week",
+	skeletonFieldHour:      "Hour",
+	skeletonFieldMinute:    "Minute",
+	skeletonFieldSecond:    "Second",
+	skeletonFieldTimeZone:  "Timezone",
+}
+
+// skeletonFieldTypes maps skeleton symbols to their field types
+var skeletonFieldTypes = map[byte]int{
+	'G': skeletonFieldEra,
+	'y': skeletonFieldYear,
+	'Y': skeletonFieldYear,
+	'u': skeletonFieldYear,
+	'U': skeletonFieldYear,
+	'r': skeletonFieldYear,
+	'Q': skeletonFieldQuarter,
+	'q': skeletonFieldQuarter,
+	'M': skeletonFieldMonth,
+	'L': skeletonFieldMonth,
+	'w': skeletonFieldWeek,
+	'W': skeletonFieldWeek,
+	'd': skeletonFieldDay,
+	'D': skeletonFieldDay,
+	'F': skeletonFieldDay,
+	'g': skeletonFieldDay,
+	'E': skeletonFieldDayOfWeek,
+	'c': skeletonFieldDayOfWeek,
+	'e': skeletonFieldDayOfWeek,
+	'a': skeletonFieldPeriod,
+	'b': skeletonFieldPeriod,
+	'B': skeletonFieldPeriod,
+	'h': skeletonFieldHour,
+	'H': skeletonFieldHour,
+	'K': skeletonFieldHour,
+	'k': skeletonFieldHour,
+	'm': skeletonFieldMinute,
+	's': skeletonFieldSecond,
+	'S': skeletonFieldFractionalSecond,
+	'A': skeletonFieldFractionalSecond,
+	'z': skeletonFieldTimeZone,
+	'Z': skeletonFieldTimeZone,
+	'O': skeletonFieldTimeZone,
+	'v': skeletonFieldTimeZone,
+	'V': skeletonFieldTimeZone,
+	'X': skeletonFieldTimeZone,
+	'x': skeletonFieldTimeZone,
+}
+
+// skeletonField is a single field of a skeleton, like "MMM"
+type skeletonField struct {
+	symbol byte
+	length int
+}
+
+// text returns whether the field is rendered as a name rather than a number
+func (f skeletonField) text() bool {
+	switch f.symbol {
+	case 'G', 'E', 'a', 'b', 'B', 'z', 'Z', 'O', 'v', 'V', 'X', 'x':
+		return true
+	case 'M', 'L', 'Q', 'q', 'c', 'e':
+		return f.length >= datetimeFormatLengthAbbreviated
+	}
+
+	return false
+}
+
+// FormatDateTimeSkeleton takes a time struct and a skeleton, which lists the
+// fields to show without any order or punctuation, and returns a formatted
+// string using the locale's best pattern for the fields. For example, the
+// skeleton "MMMd" is "Jan 2" in English and "2 janv." in French, and "jm" is
+// the hour and minute with the locale's preferred 12-hour or 24-hour clock.
+// Field widths are adjusted to the ones asked for, so "MMMMd" uses the same
+// pattern as "MMMd" in locales which don't have one of their own. Skeletons
+// with both date and time fields which the locale doesn't have a pattern for
+// are split into a date and a time, and combined with the locale's datetime
+// format. Fields which no pattern has, like the week in "yw", are appended to
+// the best pattern for the other fields with the locale's appendItems rules,
+// so "yw" is "2006 (week: 1)" in English.
+func (t *Translator) FormatDateTimeSkeleton(skeleton string, datetime time.Time) (string, error) {
+	pattern, err := t.skeletonPattern(skeleton)
+	if err != nil {
+		return "", err
+	}
+
+	return t.FormatDateTimePattern(pattern, datetime)
+}
+
+// skeletonPattern returns the locale's best pattern for a skeleton.
+func (t *Translator) skeletonPattern(skeleton string) (string, error) {
+	fields, err := t.parseSkeleton(skeleton)
+	if err != nil {
+		return "", err
+	}
+
+	if pattern, ok := t.bestSkeletonPattern(fields); ok {
+		return pattern, nil
+	}
+
+	// split the skeleton into date and time fields, and match them separately
+	var dateFields, timeFields []skeletonField
+	for _, field := range fields {
+		if skeletonFieldTypes[field.symbol] >= skeletonFieldPeriod {
+			timeFields = append(timeFields, field)
+		} else {
+			dateFields = append(dateFields, field)
+		}
+	}
+
+	if len(dateFields) > 0 && len(timeFields) > 0 {
+		datePattern, dateOk := t.appendSkeletonPattern(dateFields)
+		timePattern, timeOk := t.appendSkeletonPattern(timeFields)
+		if dateOk && timeOk {
+			return getDateTimePattern(t.skeletonDateTimePattern(dateFields), datePattern, timePattern), nil
+		}
+	}
+
+	if pattern, ok := t.appendSkeletonPattern(fields); ok {
+		return pattern, nil
+	}
+
+	return "", translatorError{message: "no datetime pattern for skeleton: " + skeleton}
+}
+
+// appendSkeletonPattern returns the best pattern for the fields, like
+// bestSkeletonPattern, but when there's no pattern with all of them, it uses
+// the pattern with the most of them, and appends the missing fields with the
+// locale's appendItems rules. In an append item, "{0}" is the pattern so far,
+// "{1}" is the pattern for the missing field, and "{2}" is the field's name.
+func (t *Translator) appendSkeletonPattern(fields []skeletonField) (string, bool) {
+	if pattern, ok := t.bestSkeletonPattern(fields); ok {
+		return pattern, true
+	}
+
+	fields = withoutAMPM(fields)
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	bestSkeleton, bestPattern, bestCount, bestDistance := "", "", 0, -1
+	var bestAvailable []skeletonField
+
+	for skeleton, pattern := range t.rules.DateTime.AvailableFormats {
+		available, err := t.parseSkeleton(skeleton)
+		if err != nil {
+			continue
+		}
+		available = withoutAMPM(available)
+
+		subset := []skeletonField{}
+		for _, field := range fields {
+			if _, ok := findSkeletonField(available, skeletonFieldTypes[field.symbol]); ok {
+				subset = append(subset, field)
+			}
+		}
+
+		distance, ok := skeletonDistance(subset, available)
+		if !ok {
+			continue
+		}
+
+		if len(subset) > bestCount || (len(subset) == bestCount && (distance < bestDistance || (distance == bestDistance && skeleton < bestSkeleton))) {
+			bestSkeleton, bestPattern, bestCount, bestDistance = skeleton, pattern, len(subset), distance
+			bestAvailable = available
+		}
+	}
+
+	// without any pattern to start with, start with the first field
+	var pattern string
+	missing := []skeletonField{}
+	if bestCount > 0 {
+		pattern = adjustSkeletonPattern(bestPattern, fields)
+		for _, field := range fields {
+			if _, ok := findSkeletonField(bestAvailable, skeletonFieldTypes[field.symbol]); !ok {
+				missing = append(missing, field)
+			}
+		}
+	} else {
+		pattern = t.skeletonFieldPattern(fields[0])
+		missing = append(missing, fields[1:]...)
+	}
+
+	// append the missing fields in the order of their types, like "yw"
+	// appends the week after the year
+	sort.SliceStable(missing, func(i, j int) bool {
+		return skeletonFieldTypes[missing[i].symbol] < skeletonFieldTypes[missing[j].symbol]
+	})
+
+	for _, field := range missing {
+		name, ok := skeletonFieldNames[skeletonFieldTypes[field.symbol]]
+		if !ok {
+			return "", false
+		}
+
+		item, ok := t.rules.DateTime.AppendItems[name]
+		if !ok {
+			return "", false
+		}
+
+		displayName := name
+		if fieldName, ok := t.rules.DateTime.FieldNames[name]; ok {
+			displayName = fieldName
+		}
+		displayName = "'" + strings.Replace(displayName, "'", "''", -1) + "'"
+
+		pattern = strings.NewReplacer("{0}", pattern, "{1}", t.skeletonFieldPattern(field), "{2}", displayName).Replace(item)
+	}
+
+	return pattern, true
+}
+
+// skeletonFieldPattern returns the pattern for a single field - the locale's
+// best pattern for it, or otherwise the field itself.
+func (t *Translator) skeletonFieldPattern(field skeletonField) string {
+	if pattern, ok := t.bestSkeletonPattern([]skeletonField{field}); ok {
+		return pattern
+	}
+
+	return strings.Repeat(string(field.symbol), field.length)
+}
+
+// parseSkeleton splits a skeleton into its fields. The "j" and "C" symbols
+// are replaced with the locale's preferred hour symbol, and "J" with its
+// preferred hour symbol without a day period.
+func (t *Translator) parseSkeleton(skeleton string) ([]skeletonField, error) {
+	fields := []skeletonField{}
+	seen := map[int]bool{}
+
+	for i := 0; i < len(skeleton); {
+		end := lastSequenceIndex(skeleton[i:]) + i + 1
+		field := skeletonField{symbol: skeleton[i], length: end - i}
+		i = end
+
+		switch field.symbol {
+		case 'j', 'C':
+			field.symbol = t.preferredHour()
+		case 'J':
+			field.symbol = t.preferredHour()
+			if field.symbol == 'h' {
+				field.symbol = 'H'
+			} else if field.symbol == 'K' {
+				field.symbol = 'k'
+			}
+		}
+
+		fieldType, ok := skeletonFieldTypes[field.symbol]
+		if !ok {
+			return nil, translatorError{message: "unknown datetime skeleton field: " + string(field.symbol)}
+		}
+		if seen[fieldType] {
+			return nil, translatorError{message: "repeated datetime skeleton field: " + string(field.symbol)}
+		}
+		seen[fieldType] = true
+
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, translatorError{message: "empty datetime skeleton"}
+	}
+
+	return fields, nil
+}
+
+// preferredHour returns the hour symbol the locale's short time format uses -
+// "h" for locales which prefer a 12-hour clock, and "H" for a 24-hour clock.
+func (t *Translator) preferredHour() byte {
+	pattern, err := t.parseDateTimeFormat(t.rules.DateTime.Formats.Time.Short)
+	if err == nil {
+		for _, component := range pattern {
+			if component.componentType == datetimePatternComponentUnit && skeletonFieldTypes[component.pattern[0]] == skeletonFieldHour {
+				return component.pattern[0]
+			}
+		}
+	}
+
+	return 'H'
+}
+
+// bestSkeletonPattern finds the locale's available format with the same
+// field types as the fields and the smallest distance from them, and returns
+// its pattern with the field widths adjusted to the requested ones. AM/PM
+// fields aren't matched, because the patterns for 12-hour clocks already have
+// them.
+func (t *Translator) bestSkeletonPattern(fields []skeletonField) (string, bool) {
+	bestSkeleton, bestPattern, bestDistance := "", "", -1
+
+	for skeleton, pattern := range t.rules.DateTime.AvailableFormats {
+		available, err := t.parseSkeleton(skeleton)
+		if err != nil {
+			continue
+		}
+
+		distance, ok := skeletonDistance(withoutAMPM(fields), withoutAMPM(available))
+		if !ok {
+			continue
+		}
+
+		// break ties by the skeleton, so the result doesn't depend on the
+		// order of the map
+		if bestDistance == -1 || distance < bestDistance || (distance == bestDistance && skeleton < bestSkeleton) {
+			bestSkeleton, bestPattern, bestDistance = skeleton, pattern, distance
+		}
+	}
+
+	if bestDistance == -1 {
+		return "", false
+	}
+
+	return adjustSkeletonPattern(bestPattern, fields), true
+}
+
+// withoutAMPM returns the fields other than an AM/PM field
+func withoutAMPM(fields []skeletonField) []skeletonField {
+	without := make([]skeletonField, 0, len(fields))
+	for _, field := range fields {
+		if field.symbol != datetimeFormatUnitPeriod {
+			without = append(without, field)
+		}
+	}
+
+	return without
+}
+
+// skeletonDistance returns how different an available format's skeleton is
+// from the requested fields. Skeletons with different field types don't
+// match at all.
+func skeletonDistance(fields, available []skeletonField) (int, bool) {
+	if len(fields) != len(available) {
+		return 0, false
+	}
+
+	distance := 0
+	for _, field := range fields {
+		match, ok := findSkeletonField(available, skeletonFieldTypes[field.symbol])
+		if !ok {
+			return 0, false
+		}
+
+		if field.text() != match.text() {
+			distance += skeletonDistanceNumericText
+		}
+		if canonicalSkeletonSymbol(field.symbol) != canonicalSkeletonSymbol(match.symbol) {
+			distance += skeletonDistanceSymbol
+		}
+		if field.length > match.length {
+			distance += field.length - match.length
+		} else {
+			distance += match.length - field.length
+		}
+	}
+
+	return distance, true
+}
+
+// canonicalSkeletonSymbol returns the symbol a skeleton field is matched by -
+// stand-alone and local symbols are matched as their format symbols.
+func canonicalSkeletonSymbol(symbol byte) byte {
+	switch symbol {
+	case 'L':
+		return 'M'
+	case 'c', 'e':
+		return 'E'
+	case 'q':
+		return 'Q'
+	}
+
+	return symbol
+}
+
+// findSkeletonField returns the field of a type
+func findSkeletonField(fields []skeletonField, fieldType int) (skeletonField, bool) {
+	for _, field := range fields {
+		if skeletonFieldTypes[field.symbol] == fieldType {
+			return field, true
+		}
+	}
+
+	return skeletonField{}, false
+}
+
+// adjustSkeletonPattern changes the widths of the fields in a pattern to the
+// requested ones. Names get the requested width, and numbers are padded to a
+// longer requested length, but hours, minutes and seconds keep the pattern's
+// length, and numbers aren't changed to names, or names to numbers. Months,
+// days of the week and hours keep the pattern's symbol, since the locale chose
+// stand-alone names or its clock for them, and other fields use the requested
+// symbol. Text that isn't a field, like the "年" in "y年M月", is copied byte by
+// byte.
+func adjustSkeletonPattern(pattern string, fields []skeletonField) string {
+	adjusted := ""
+
+	for i := 0; i < len(pattern); {
+		char := pattern[i]
+
+		if char == datetimeFormatLiteral {
+			// copy quoted text as it is, including pairs of single quotes
+			end := i + 1
+			for end < len(pattern) {
+				if pattern[end] == datetimeFormatLiteral {
+					if end+1 < len(pattern) && pattern[end+1] == datetimeFormatLiteral {
+						end += 2
+						continue
+					}
+					end++
+					break
+				}
+				end++
+			}
+
+			adjusted += pattern[i:end]
+			i = end
+			continue
+		}
+
+		if (char < 'a' || char > 'z') && (char < 'A' || char > 'Z') {
+			adjusted += pattern[i : i+1]
+			i++
+			continue
+		}
+
+		end := lastSequenceIndex(pattern[i:]) + i + 1
+		field := skeletonField{symbol: char, length: end - i}
+		i = end
+
+		if fieldType, ok := skeletonFieldTypes[char]; ok {
+			if requested, ok := findSkeletonField(fields, fieldType); ok {
+				field = adjustSkeletonField(field, requested)
+			}
+		}
+
+		adjusted += strings.Repeat(string(field.symbol), field.length)
+	}
+
+	return adjusted
+}
+
+// adjustSkeletonField returns a pattern field adjusted to a requested field of
+// the same type.
+func adjustSkeletonField(field, requested skeletonField) skeletonField {
+	switch skeletonFieldTypes[field.symbol] {
+	case skeletonFieldHour, skeletonFieldMinute, skeletonFieldSecond:
+		return field
+	case skeletonFieldMonth, skeletonFieldDayOfWeek:
+	default:
+		field.symbol = requested.symbol
+	}
+
+	switch {
+	case field.text() && requested.text():
+		// "E" to "EEE" are all abbreviated names, but "c" and "e" are
+		// numbers until "ccc" and "eee"
+		field.length = requested.length
+		if skeletonFieldTypes[field.symbol] == skeletonFieldDayOfWeek && field.length < datetimeFormatLengthAbbreviated {
+			field.length = datetimeFormatLengthAbbreviated
+		}
+	case !field.text() && !requested.text():
+		if requested.length > field.length {
+			field.length = requested.length
+		}
+	}
+
+	return field
+}
+
+// skeletonDateTimePattern returns the locale's datetime format for joining a
+// date and a time with the date fields - the full format for dates with
+// a weekday and a full month name, the long format for full month names, the
+// medium format for abbreviated month names, and otherwise the short format.
+func (t *Translator) skeletonDateTimePattern(dateFields []skeletonField) string {
+	month, hasMonth := findSkeletonField(dateFields, skeletonFieldMonth)
+	_, hasDayOfWeek := findSkeletonField(dateFields, skeletonFieldDayOfWeek)
+
+	switch {
+	case hasMonth && month.length >= datetimeFormatLengthWide && hasDayOfWeek:
+		return t.rules.DateTime.Formats.DateTime.Full
+	case hasMonth && month.length >= datetimeFormatLengthWide:
+		return t.rules.DateTime.Formats.DateTime.Long
+	case hasMonth && month.length == datetimeFormatLengthAbbreviated:
+		return t.rules.DateTime.Formats.DateTime.Medium
+	}
+
+	return t.rules.DateTime.Formats.DateTime.Short
+}
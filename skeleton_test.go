@@ -0,0 +1,131 @@
+package i18n
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFormatDateTimeSkeleton(c *C) {
+	f, _ := NewTranslatorFactory(
+		[]string{"data/rules"},
+		[]string{"data/messages"},
+		"en",
+	)
+
+	c.Assert(f, NotNil)
+
+	translators := map[string]*Translator{}
+	for _, locale := range []string{"en", "en-GB", "de", "fr", "ru", "es", "pt", "ja", "zh"} {
+		translators[locale], _ = f.GetTranslator(locale)
+		c.Assert(translators[locale], NotNil)
+	}
+
+	datetime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		locale   string
+		skeleton string
+		expected string
+	}{
+		// exact matches
+		{"en", "MMMd", "Jan 2"},
+		{"en", "yMMMd", "Jan 2, 2006"},
+		{"en", "MMMMd", "January 2"},
+		{"en", "yQQQ", "Q1 2006"},
+		{"en", "Bhm", "3:04 in the afternoon"},
+		{"en", "E", "Mon"},
+
+		// adjusted widths
+		{"en", "yMMMMd", "January 2, 2006"},
+		{"en", "yMMMMEEEEd", "Monday, January 2, 2006"},
+		{"en", "MMdd", "01/02"},
+		{"en", "EEEE", "Monday"},
+		{"en", "MMMM", "January"},
+		{"en", "yyMd", "1/2/06"},
+
+		// the locale's preferred hours
+		{"en", "jm", "3:04 PM"},
+		{"en", "jms", "3:04:05 PM"},
+		{"en", "Hm", "15:04"},
+		{"en", "hma", "3:04 PM"},
+		{"en", "Jm", "15:04"},
+		{"en-GB", "jm", "15:04"},
+		{"en-GB", "Jm", "15:04"},
+		{"de", "jm", "15:04"},
+		{"de", "j", "15 Uhr"},
+		{"fr", "j", "15 h"},
+
+		// different symbols of the same type
+		{"en", "jmz", "3:04 PM UTC"},
+		{"en", "jmzzzz", "3:04 PM Coordinated Universal Time"},
+
+		// dates and times without a pattern for both
+		{"en", "yMdjm", "1/2/2006, 3:04 PM"},
+		{"en", "yMMMdjm", "Jan 2, 2006, 3:04 PM"},
+		{"en", "yMMMMdjm", "January 2, 2006 at 3:04 PM"},
+		{"en-GB", "yMMMdjm", "2 Jan 2006 15:04"},
+
+		// locale orders
+		{"en-GB", "yMMMd", "2 Jan 2006"},
+		{"en-GB", "yMd", "02/01/2006"},
+		{"en-GB", "MEd", "Mon 02/01"},
+		{"de", "MMMd", "2. Jan."},
+		{"de", "Md", "2.1."},
+		{"de", "yMMMMEEEEd", "Montag, 2. Januar 2006"},
+		{"fr", "MMMd", "2 janv."},
+		{"fr", "yMMMEd", "lun. 2 janv. 2006"},
+		{"ru", "yMd", "2006-01-02"},
+		{"es", "yMMMMd", "2 de enero de 2006"},
+		{"es", "MMMEd", "lun, 2 ene"},
+		{"pt", "yMMMd", "2 de jan de 2006"},
+		{"pt", "jm", "15:04"},
+
+		// locales with other scripts
+		{"ja", "yMMMMd", "2006年1月2日"},
+		{"ja", "yMMMEd", "2006年1月2日(月)"},
+		{"ja", "hm", "午後3:04"},
+		{"ja", "jm", "15:04"},
+		{"ja", "MMMd", "1月2日"},
+		{"ja", "yQQQQ", "2006年第1四半期"},
+		{"zh", "yMMMMd", "2006年1月2日"},
+		{"zh", "MMMEd", "1月2日周一"},
+		{"zh", "hm", "下午3:04"},
+		{"zh", "jm", "下午3:04"},
+
+		// fields without a pattern are appended
+		{"en", "Q", "1"},
+		{"en", "QQQ", "Q1"},
+		{"en", "zzzz", "Coordinated Universal Time"},
+		{"en", "yw", "2006 (week: 1)"},
+		{"en", "yMMMw", "Jan 2006 (week: 1)"},
+		{"en", "yMdjmw", "1/2/2006 (week: 1), 3:04 PM"},
+		{"de", "yw", "2006 (Woche: 1)"},
+		{"fr", "MMMdw", "2 janv. (semaine: 1)"},
+		{"zh", "yw", "2006年 (周: 1)"},
+	}
+
+	for _, test := range tests {
+		comment := Commentf("%s %s", test.locale, test.skeleton)
+		str, err := translators[test.locale].FormatDateTimeSkeleton(test.skeleton, datetime)
+		c.Check(err, IsNil, comment)
+		c.Check(str, Equals, test.expected, comment)
+	}
+
+	for _, skeleton := range []string{"", "a", "T", "MMdM", "hH"} {
+		_, err := translators["en"].FormatDateTimeSkeleton(skeleton, datetime)
+		c.Check(err, NotNil, Commentf(skeleton))
+	}
+}
+
+func (s *MySuite) TestAdjustSkeletonPattern(c *C) {
+	fields := []skeletonField{{'y', 2}, {'M', 4}, {'E', 1}, {'d', 2}, {'H', 1}, {'m', 1}}
+
+	c.Check(adjustSkeletonPattern("E, MMM d, y", fields), Equals, "EEE, MMMM dd, yy")
+	c.Check(adjustSkeletonPattern("ccc d LLL", fields), Equals, "ccc dd LLLL")
+	c.Check(adjustSkeletonPattern("HH:mm", fields), Equals, "HH:mm")
+	c.Check(adjustSkeletonPattern("HH 'Uhr' mm 'd''M'", fields), Equals, "HH 'Uhr' mm 'd''M'")
+
+	// numbers aren't changed to names, or the other way around
+	c.Check(adjustSkeletonPattern("MM/y", fields), Equals, "MM/yy")
+}
@@ -0,0 +1,245 @@
+package i18n
+
+import (
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// MessageSource is a source of messages for a TranslatorFactory, like a
+// directory of messages files or a database. Sources are layered - when a
+// factory has multiple message sources, messages from sources later in the
+// list override the ones from earlier sources.
+type MessageSource interface {
+	// Messages returns the messages for a locale. Messages with named
+	// variants, like plural messages organized by plural category, are
+	// returned in the variants map. Messages with a context use the context
+	// and key joined with "\x04" as their key, like gettext does. The locale's
+	// rules are passed in for formats that need them, like gettext catalogs
+	// which number their plural forms. Both maps are nil if the source has no
+	// messages for the locale.
+	Messages(locale string, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error)
+
+	// HasMessages returns whether the source has messages for a locale,
+	// without loading them
+	HasMessages(locale string) (exists bool, errors []error)
+}
+
+// RulesSource is a source of locale rules for a TranslatorFactory. Sources are
+// layered the same way as message sources.
+type RulesSource interface {
+	// Rules returns the rules for a single locale, or nil if the source has
+	// no rules for it. The factory merges the rules for "root", the locale's
+	// less specific locales and the locale itself, so only the rules that
+	// are different from those need to be returned.
+	Rules(locale string) (rules *TranslatorRules, errors []error)
+}
+
+// FSSource is a MessageSource and RulesSource which loads rules and messages
+// files from a file system. It's what NewTranslatorFactory uses for its paths,
+// and what NewTranslatorFactoryFS uses for its file systems. The names of the
+// files in the root of the file system are listed the first time they're
+// needed, and again each time a factory using the source is reloaded, so new
+// locales are found after a Reload.
+type FSSource struct {
+	fsys fs.FS
+
+	mutex   sync.Mutex
+	index   localeIndex
+	indexed bool
+}
+
+// NewFSSource returns an FSSource for a file system
+func NewFSSource(fsys fs.FS) *FSSource {
+	return &FSSource{fsys: fsys}
+}
+
+// NewDirSource returns an FSSource for a directory on disk
+func NewDirSource(dir string) *FSSource {
+	return &FSSource{fsys: os.DirFS(dir)}
+}
+
+// Messages loads the messages files for a locale, in the same order as
+// NewTranslatorFactory describes. The maps are nil if none of the files could
+// be loaded.
+func (s *FSSource) Messages(locale string, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error) {
+	files, errs := messagesFiles(s.fsys, s.localeIndex(), locale)
+	for _, err := range errs {
+		errors = append(errors, err)
+	}
+
+	newMessages := make(map[string]string)
+	newVariants := make(map[string]map[string]string)
+
+	for _, file := range files {
+		err := loadMessagesFile(s.fsys, file, rules, newMessages, newVariants)
+		if err != nil {
+			errors = append(errors, err)
+		} else {
+			messages = newMessages
+			variants = newVariants
+		}
+	}
+
+	return
+}
+
+// HasMessages returns whether there are any messages files for a locale
+func (s *FSSource) HasMessages(locale string) (exists bool, errors []error) {
+	files, errors := messagesFiles(s.fsys, s.localeIndex(), locale)
+	exists = len(files) > 0
+	return
+}
+
+// Rules loads the rules files for a locale
+func (s *FSSource) Rules(locale string) (rules *TranslatorRules, errors []error) {
+	for _, file := range rulesFiles(s.fsys, s.localeIndex(), locale) {
+		fileRules, err := loadRulesFile(file)
+		if err != nil {
+			errors = append(errors, err)
+		}
+
+		if fileRules != nil {
+			if rules == nil {
+				rules = new(TranslatorRules)
+			}
+			rules.merge(fileRules)
+		}
+	}
+
+	return
+}
+
+// localeIndex returns the index of the files named after locales in the root
+// of the source's file system, listing it if it hasn't been listed yet
+func (s *FSSource) localeIndex() localeIndex {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.indexed {
+		s.index = newLocaleIndex(s.fsys)
+		s.indexed = true
+	}
+	return s.index
+}
+
+// reindex makes the source list its file system again the next time it's
+// needed
+func (s *FSSource) reindex() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.index = nil
+	s.indexed = false
+}
+
+// MemorySource is a MessageSource and RulesSource which keeps its messages and
+// rules in memory. It's useful for messages that come from somewhere other than
+// files, and for tests. It's safe to add messages and rules while it's being
+// used by a factory, but translators the factory has already created won't
+// see them. Locale codes are canonicalized, so messages added for "en_gb" are
+// found for "en-GB".
+type MemorySource struct {
+	mutex    sync.RWMutex
+	messages map[string]map[string]string
+	variants map[string]map[string]map[string]string
+	rules    map[string]*TranslatorRules
+}
+
+// NewMemorySource returns an empty MemorySource
+func NewMemorySource() *MemorySource {
+	return &MemorySource{
+		messages: map[string]map[string]string{},
+		variants: map[string]map[string]map[string]string{},
+		rules:    map[string]*TranslatorRules{},
+	}
+}
+
+// AddMessages adds messages for a locale, replacing any messages with the same
+// keys. The messages are organized the same way as in a messages file - values
+// are either strings, or maps for messages with named variants and nested
+// messages, and messages can be grouped by context under the "_context" key.
+//
+//     s.AddMessages("en", map[string]interface{}{
+//         "WELCOME":    "Welcome!",
+//         "ITEM_COUNT": map[string]interface{}{"one": "{n} item", "other": "{n} items"},
+//     })
+func (s *MemorySource) AddMessages(locale string, messages map[string]interface{}) {
+	newMessages := map[string]string{}
+	newVariants := map[string]map[string]string{}
+	addMessageTree(messages, newMessages, newVariants)
+	locale = CanonicalLocale(locale)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.messages[locale] == nil {
+		s.messages[locale] = map[string]string{}
+		s.variants[locale] = map[string]map[string]string{}
+	}
+
+	for key, value := range newMessages {
+		s.messages[locale][key] = value
+		delete(s.variants[locale], key)
+	}
+
+	for key, value := range newVariants {
+		s.variants[locale][key] = value
+		delete(s.messages[locale], key)
+	}
+}
+
+// SetRules sets the rules for a locale. Like a rules file, they only need to
+// include the rules that are different from the ones for "root" and the
+// locale's less specific locales.
+func (s *MemorySource) SetRules(locale string, rules TranslatorRules) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.rules[CanonicalLocale(locale)] = &rules
+}
+
+// Messages returns a copy of the messages added for a locale
+func (s *MemorySource) Messages(locale string, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error) {
+	locale = CanonicalLocale(locale)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.messages[locale] == nil {
+		return
+	}
+
+	messages = make(map[string]string, len(s.messages[locale]))
+	for key, value := range s.messages[locale] {
+		messages[key] = value
+	}
+
+	variants = make(map[string]map[string]string, len(s.variants[locale]))
+	for key, value := range s.variants[locale] {
+		variants[key] = value
+	}
+
+	return
+}
+
+// HasMessages returns whether any messages have been added for a locale
+func (s *MemorySource) HasMessages(locale string) (exists bool, errors []error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	exists = s.messages[CanonicalLocale(locale)] != nil
+	return
+}
+
+// Rules returns the rules set for a locale
+func (s *MemorySource) Rules(locale string) (rules *TranslatorRules, errors []error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if r, ok := s.rules[CanonicalLocale(locale)]; ok {
+		rules = new(TranslatorRules)
+		rules.merge(r)
+	}
+	return
+}
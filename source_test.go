@@ -0,0 +1,168 @@
+package i18n
+
+import (
+	"testing/fstest"
+
+	. "gopkg.in/check.v1"
+)
+
+// countingSource is a MessageSource which counts how many times its messages
+// are loaded
+type countingSource struct {
+	loads int
+}
+
+func (s *countingSource) Messages(locale string, rules *TranslatorRules) (messages map[string]string, variants map[string]map[string]string, errors []error) {
+	if locale != "en" {
+		return
+	}
+	s.loads++
+	return map[string]string{"SOURCE": "counting"}, nil, nil
+}
+
+func (s *countingSource) HasMessages(locale string) (bool, []error) {
+	return locale == "en", nil
+}
+
+func (s *MySuite) TestMemorySource(c *C) {
+	m := NewMemorySource()
+	m.SetRules("en", TranslatorRules{Plural: "2A", Direction: "LTR"})
+	m.AddMessages("en", map[string]interface{}{
+		"WELCOME":    "Welcome!",
+		"ITEM_COUNT": map[string]interface{}{"one": "{n} item", "other": "{n} items"},
+		"checkout":   map[string]interface{}{"title": "Checkout"},
+		"_context":   map[string]interface{}{"store": map[string]interface{}{"OPEN": "Open now"}},
+	})
+
+	exists, errors := m.HasMessages("en")
+	c.Check(exists, Equals, true)
+	c.Check(errors, HasLen, 0)
+
+	exists, _ = m.HasMessages("fr")
+	c.Check(exists, Equals, false)
+
+	messages, variants, errors := m.Messages("fr", nil)
+	c.Check(messages, IsNil)
+	c.Check(variants, IsNil)
+	c.Check(errors, HasLen, 0)
+
+	rules, _ := m.Rules("fr")
+	c.Check(rules, IsNil)
+
+	// messages replace the ones added before them
+	m.AddMessages("en", map[string]interface{}{"ITEM_COUNT": "{n} things"})
+
+	messages, variants, _ = m.Messages("en", nil)
+	c.Check(messages["WELCOME"], Equals, "Welcome!")
+	c.Check(messages["ITEM_COUNT"], Equals, "{n} things")
+	c.Check(messages["checkout.title"], Equals, "Checkout")
+	c.Check(messages["store\x04OPEN"], Equals, "Open now")
+	c.Check(variants["ITEM_COUNT"], IsNil)
+
+	// the source's messages can't be changed through the returned maps
+	messages["WELCOME"] = "changed"
+	messages, _, _ = m.Messages("en", nil)
+	c.Check(messages["WELCOME"], Equals, "Welcome!")
+
+	f, errors := NewTranslatorFactoryFromSources([]RulesSource{m}, []MessageSource{m}, "en")
+	c.Check(errors, HasLen, 0)
+	c.Assert(f, NotNil)
+
+	tEn, errors := f.GetTranslator("en")
+	c.Check(errors, HasLen, 0)
+	c.Check(tEn.Direction(), Equals, "LTR")
+
+	t, _ := tEn.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome!")
+
+	t, _ = tEn.TranslateContext("store", "OPEN", map[string]string{})
+	c.Check(t, Equals, "Open now")
+
+	_, errors = NewTranslatorFactoryFromSources([]RulesSource{}, []MessageSource{}, "")
+	c.Check(errors, HasLen, 2)
+
+	_, errors = NewTranslatorFactoryFromSources([]RulesSource{NewMemorySource()}, []MessageSource{NewMemorySource()}, "en")
+	c.Check(len(errors) > 0, Equals, true)
+}
+
+func (s *MySuite) TestFSSource(c *C) {
+	fsys := fstest.MapFS{
+		"en.yaml":       {Data: []byte("WELCOME: \"Hello\"")},
+		"en/extra.json": {Data: []byte(`{"EXTRA": "Extra"}`)},
+		"fr.json":       {Data: []byte(`{"WELCOME": `)},
+		"root.yaml":     {Data: []byte("direction: LTR")},
+		"root.json":     {Data: []byte(`{"plural": "2A"}`)},
+	}
+	source := NewFSSource(fsys)
+
+	exists, _ := source.HasMessages("en")
+	c.Check(exists, Equals, true)
+
+	exists, _ = source.HasMessages("de")
+	c.Check(exists, Equals, false)
+
+	messages, _, errors := source.Messages("en", nil)
+	c.Check(errors, HasLen, 0)
+	c.Check(messages, DeepEquals, map[string]string{"WELCOME": "Hello", "EXTRA": "Extra"})
+
+	// a locale whose files can't be loaded has no messages
+	messages, variants, errors := source.Messages("fr", nil)
+	c.Check(errors, HasLen, 1)
+	c.Check(messages, IsNil)
+	c.Check(variants, IsNil)
+
+	// yaml and json rules files are merged
+	rules, errors := source.Rules("root")
+	c.Check(errors, HasLen, 0)
+	c.Assert(rules, NotNil)
+	c.Check(rules.Direction, Equals, "LTR")
+	c.Check(rules.Plural, Equals, "2A")
+
+	rules, _ = source.Rules("de")
+	c.Check(rules, IsNil)
+
+	// the file system is only listed again after it's reindexed
+	fsys["de.yaml"] = &fstest.MapFile{Data: []byte("WELCOME: \"Hallo\"")}
+
+	exists, _ = source.HasMessages("de")
+	c.Check(exists, Equals, false)
+
+	source.reindex()
+	exists, _ = source.HasMessages("de")
+	c.Check(exists, Equals, true)
+}
+
+func (s *MySuite) TestLayeredSources(c *C) {
+	m := NewMemorySource()
+	m.SetRules("en", TranslatorRules{Direction: "RTL"})
+	m.AddMessages("en", map[string]interface{}{"WELCOME": "Welcome from memory"})
+
+	counting := &countingSource{}
+
+	f, errors := NewTranslatorFactoryFromSources(
+		[]RulesSource{NewDirSource("data/rules"), m},
+		[]MessageSource{NewDirSource("data/messages"), m, counting},
+		"en",
+	)
+	c.Check(errors, HasLen, 0)
+
+	tEn, errors := f.GetTranslator("en")
+	c.Check(errors, HasLen, 0)
+
+	// rules and messages from later sources win
+	c.Check(tEn.Direction(), Equals, "RTL")
+	c.Check(tEn.rules.Plural, Equals, "2A")
+
+	t, _ := tEn.Translate("WELCOME", map[string]string{})
+	c.Check(t, Equals, "Welcome from memory")
+
+	t, _ = tEn.Translate("WELCOME_USER", map[string]string{"user": "Ann"})
+	c.Check(t, Equals, "Welcome, Ann!")
+
+	t, _ = tEn.Translate("SOURCE", map[string]string{})
+	c.Check(t, Equals, "counting")
+
+	// translators are cached, so messages are only loaded once
+	f.GetTranslator("en")
+	c.Check(counting.loads, Equals, 1)
+}
@@ -0,0 +1,547 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// metazones maps time zone IDs to the CLDR metazones whose names they share -
+// "America/Los_Angeles" and "America/Vancouver" are both "Pacific Time". Zones
+// which aren't in a metazone only have the names the rules give the zone
+// itself.
+var metazones = map[string]string{
+	"Africa/Abidjan":                 "GMT",
+	"Africa/Accra":                   "GMT",
+	"Africa/Addis_Ababa":             "Africa_Eastern",
+	"Africa/Algiers":                 "Europe_Central",
+	"Africa/Bamako":                  "GMT",
+	"Africa/Bangui":                  "Africa_Western",
+	"Africa/Blantyre":                "Africa_Central",
+	"Africa/Brazzaville":             "Africa_Western",
+	"Africa/Cairo":                   "Europe_Eastern",
+	"Africa/Ceuta":                   "Europe_Central",
+	"Africa/Dakar":                   "GMT",
+	"Africa/Dar_es_Salaam":           "Africa_Eastern",
+	"Africa/Douala":                  "Africa_Western",
+	"Africa/Gaborone":                "Africa_Central",
+	"Africa/Harare":                  "Africa_Central",
+	"Africa/Johannesburg":            "Africa_Southern",
+	"Africa/Kampala":                 "Africa_Eastern",
+	"Africa/Khartoum":                "Africa_Central",
+	"Africa/Kigali":                  "Africa_Central",
+	"Africa/Kinshasa":                "Africa_Western",
+	"Africa/Lagos":                   "Africa_Western",
+	"Africa/Luanda":                  "Africa_Western",
+	"Africa/Lubumbashi":              "Africa_Central",
+	"Africa/Lusaka":                  "Africa_Central",
+	"Africa/Maputo":                  "Africa_Central",
+	"Africa/Maseru":                  "Africa_Southern",
+	"Africa/Mbabane":                 "Africa_Southern",
+	"Africa/Mogadishu":               "Africa_Eastern",
+	"Africa/Monrovia":                "GMT",
+	"Africa/Nairobi":                 "Africa_Eastern",
+	"Africa/Tripoli":                 "Europe_Eastern",
+	"Africa/Tunis":                   "Europe_Central",
+	"Africa/Windhoek":                "Africa_Central",
+	"America/Adak":                   "Hawaii_Aleutian",
+	"America/Anchorage":              "Alaska",
+	"America/Argentina/Buenos_Aires": "Argentina",
+	"America/Argentina/Cordoba":      "Argentina",
+	"America/Bahia":                  "Brasilia",
+	"America/Barbados":               "Atlantic",
+	"America/Belem":                  "Brasilia",
+	"America/Belize":                 "America_Central",
+	"America/Bogota":                 "Colombia",
+	"America/Boise":                  "America_Mountain",
+	"America/Cancun":                 "America_Eastern",
+	"America/Caracas":                "Venezuela",
+	"America/Chicago":                "America_Central",
+	"America/Costa_Rica":             "America_Central",
+	"America/Denver":                 "America_Mountain",
+	"America/Detroit":                "America_Eastern",
+	"America/Edmonton":               "America_Mountain",
+	"America/El_Salvador":            "America_Central",
+	"America/Fortaleza":              "Brasilia",
+	"America/Glace_Bay":              "Atlantic",
+	"America/Goose_Bay":              "Atlantic",
+	"America/Guatemala":              "America_Central",
+	"America/Halifax":                "Atlantic",
+	"America/Hermosillo":             "Mexico_Pacific",
+	"America/Indiana/Indianapolis":   "America_Eastern",
+	"America/Jamaica":                "America_Eastern",
+	"America/Juneau":                 "Alaska",
+	"America/Kentucky/Louisville":    "America_Eastern",
+	"America/Lima":                   "Peru",
+	"America/Los_Angeles":            "America_Pacific",
+	"America/Managua":                "America_Central",
+	"America/Martinique":             "Atlantic",
+	"America/Mazatlan":               "Mexico_Pacific",
+	"America/Merida":                 "America_Central",
+	"America/Mexico_City":            "America_Central",
+	"America/Moncton":                "Atlantic",
+	"America/Monterrey":              "America_Central",
+	"America/Nassau":                 "America_Eastern",
+	"America/New_York":               "America_Eastern",
+	"America/Nome":                   "Alaska",
+	"America/Panama":                 "America_Eastern",
+	"America/Phoenix":                "America_Mountain",
+	"America/Port-au-Prince":         "America_Eastern",
+	"America/Puerto_Rico":            "Atlantic",
+	"America/Recife":                 "Brasilia",
+	"America/Regina":                 "America_Central",
+	"America/Santiago":               "Chile",
+	"America/Santo_Domingo":          "Atlantic",
+	"America/Sao_Paulo":              "Brasilia",
+	"America/Sitka":                  "Alaska",
+	"America/St_Johns":               "Newfoundland",
+	"America/Tegucigalpa":            "America_Central",
+	"America/Tijuana":                "America_Pacific",
+	"America/Toronto":                "America_Eastern",
+	"America/Vancouver":              "America_Pacific",
+	"America/Winnipeg":               "America_Central",
+	"America/Yakutat":                "Alaska",
+	"Asia/Aden":                      "Arabian",
+	"Asia/Baghdad":                   "Arabian",
+	"Asia/Bahrain":                   "Arabian",
+	"Asia/Bangkok":                   "Indochina",
+	"Asia/Beirut":                    "Europe_Eastern",
+	"Asia/Colombo":                   "India",
+	"Asia/Dhaka":                     "Bangladesh",
+	"Asia/Dubai":                     "Gulf",
+	"Asia/Ho_Chi_Minh":               "Indochina",
+	"Asia/Hong_Kong":                 "Hong_Kong",
+	"Asia/Jakarta":                   "Indonesia_Western",
+	"Asia/Jerusalem":                 "Israel",
+	"Asia/Kabul":                     "Afghanistan",
+	"Asia/Karachi":                   "Pakistan",
+	"Asia/Kathmandu":                 "Nepal",
+	"Asia/Kolkata":                   "India",
+	"Asia/Kuala_Lumpur":              "Malaysia",
+	"Asia/Kuwait":                    "Arabian",
+	"Asia/Macau":                     "China",
+	"Asia/Manila":                    "Philippines",
+	"Asia/Muscat":                    "Gulf",
+	"Asia/Nicosia":                   "Europe_Eastern",
+	"Asia/Phnom_Penh":                "Indochina",
+	"Asia/Qatar":                     "Arabian",
+	"Asia/Riyadh":                    "Arabian",
+	"Asia/Seoul":                     "Korea",
+	"Asia/Shanghai":                  "China",
+	"Asia/Singapore":                 "Singapore",
+	"Asia/Taipei":                    "Taipei",
+	"Asia/Tehran":                    "Iran",
+	"Asia/Tokyo":                     "Japan",
+	"Asia/Vientiane":                 "Indochina",
+	"Atlantic/Bermuda":               "Atlantic",
+	"Atlantic/Canary":                "Europe_Western",
+	"Atlantic/Faroe":                 "Europe_Western",
+	"Atlantic/Madeira":               "Europe_Western",
+	"Atlantic/Reykjavik":             "GMT",
+	"Australia/Adelaide":             "Australia_Central",
+	"Australia/Brisbane":             "Australia_Eastern",
+	"Australia/Darwin":               "Australia_Central",
+	"Australia/Hobart":               "Australia_Eastern",
+	"Australia/Melbourne":            "Australia_Eastern",
+	"Australia/Perth":                "Australia_Western",
+	"Australia/Sydney":               "Australia_Eastern",
+	"Etc/GMT":                        "GMT",
+	"Europe/Amsterdam":               "Europe_Central",
+	"Europe/Andorra":                 "Europe_Central",
+	"Europe/Athens":                  "Europe_Eastern",
+	"Europe/Belgrade":                "Europe_Central",
+	"Europe/Berlin":                  "Europe_Central",
+	"Europe/Bratislava":              "Europe_Central",
+	"Europe/Brussels":                "Europe_Central",
+	"Europe/Bucharest":               "Europe_Eastern",
+	"Europe/Budapest":                "Europe_Central",
+	"Europe/Chisinau":                "Europe_Eastern",
+	"Europe/Copenhagen":              "Europe_Central",
+	"Europe/Dublin":                  "GMT",
+	"Europe/Gibraltar":               "Europe_Central",
+	"Europe/Guernsey":                "GMT",
+	"Europe/Helsinki":                "Europe_Eastern",
+	"Europe/Isle_of_Man":             "GMT",
+	"Europe/Jersey":                  "GMT",
+	"Europe/Kaliningrad":             "Europe_Eastern",
+	"Europe/Kyiv":                    "Europe_Eastern",
+	"Europe/Lisbon":                  "Europe_Western",
+	"Europe/Ljubljana":               "Europe_Central",
+	"Europe/London":                  "GMT",
+	"Europe/Luxembourg":              "Europe_Central",
+	"Europe/Madrid":                  "Europe_Central",
+	"Europe/Malta":                   "Europe_Central",
+	"Europe/Monaco":                  "Europe_Central",
+	"Europe/Moscow":                  "Moscow",
+	"Europe/Oslo":                    "Europe_Central",
+	"Europe/Paris":                   "Europe_Central",
+	"Europe/Prague":                  "Europe_Central",
+	"Europe/Riga":                    "Europe_Eastern",
+	"Europe/Rome":                    "Europe_Central",
+	"Europe/Sarajevo":                "Europe_Central",
+	"Europe/Simferopol":              "Moscow",
+	"Europe/Skopje":                  "Europe_Central",
+	"Europe/Sofia":                   "Europe_Eastern",
+	"Europe/Stockholm":               "Europe_Central",
+	"Europe/Tallinn":                 "Europe_Eastern",
+	"Europe/Tirane":                  "Europe_Central",
+	"Europe/Vaduz":                   "Europe_Central",
+	"Europe/Vienna":                  "Europe_Central",
+	"Europe/Vilnius":                 "Europe_Eastern",
+	"Europe/Warsaw":                  "Europe_Central",
+	"Europe/Zagreb":                  "Europe_Central",
+	"Europe/Zurich":                  "Europe_Central",
+	"Pacific/Auckland":               "New_Zealand",
+	"Pacific/Honolulu":               "Hawaii_Aleutian",
+}
+
+// timeZoneAliases maps deprecated and alternative time zone IDs to the IDs
+// zone names are given for
+var timeZoneAliases = map[string]string{
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	"America/Indianapolis": "America/Indiana/Indianapolis",
+	"America/Louisville":   "America/Kentucky/Louisville",
+	"Asia/Calcutta":        "Asia/Kolkata",
+	"Asia/Katmandu":        "Asia/Kathmandu",
+	"Asia/Saigon":          "Asia/Ho_Chi_Minh",
+	"Australia/ACT":        "Australia/Sydney",
+	"Australia/NSW":        "Australia/Sydney",
+	"Europe/Kiev":          "Europe/Kyiv",
+	"Etc/GMT+0":            "Etc/GMT",
+	"Etc/GMT-0":            "Etc/GMT",
+	"Etc/GMT0":             "Etc/GMT",
+	"Etc/Greenwich":        "Etc/GMT",
+	"Etc/UCT":              "Etc/UTC",
+	"Etc/Universal":        "Etc/UTC",
+	"Etc/Zulu":             "Etc/UTC",
+	"GB":                   "Europe/London",
+	"GMT":                  "Etc/GMT",
+	"Japan":                "Asia/Tokyo",
+	"NZ":                   "Pacific/Auckland",
+	"UCT":                  "Etc/UTC",
+	"US/Alaska":            "America/Anchorage",
+	"US/Arizona":           "America/Phoenix",
+	"US/Central":           "America/Chicago",
+	"US/Eastern":           "America/New_York",
+	"US/Hawaii":            "Pacific/Honolulu",
+	"US/Mountain":          "America/Denver",
+	"US/Pacific":           "America/Los_Angeles",
+	"UTC":                  "Etc/UTC",
+	"Universal":            "Etc/UTC",
+	"Zulu":                 "Etc/UTC",
+}
+
+// metazoneGoldenZones maps metazones with more than one zone to the zone
+// whose rules define the metazone, which a name shared by all of the zones is
+// parsed as
+var metazoneGoldenZones = map[string]string{
+	"Africa_Central":    "Africa/Maputo",
+	"Africa_Eastern":    "Africa/Nairobi",
+	"Africa_Southern":   "Africa/Johannesburg",
+	"Africa_Western":    "Africa/Lagos",
+	"Alaska":            "America/Juneau",
+	"America_Central":   "America/Chicago",
+	"America_Eastern":   "America/New_York",
+	"America_Mountain":  "America/Denver",
+	"America_Pacific":   "America/Los_Angeles",
+	"Arabian":           "Asia/Riyadh",
+	"Argentina":         "America/Argentina/Buenos_Aires",
+	"Atlantic":          "America/Halifax",
+	"Australia_Central": "Australia/Adelaide",
+	"Australia_Eastern": "Australia/Sydney",
+	"Brasilia":          "America/Sao_Paulo",
+	"China":             "Asia/Shanghai",
+	"Europe_Central":    "Europe/Paris",
+	"Europe_Eastern":    "Europe/Bucharest",
+	"Europe_Western":    "Atlantic/Canary",
+	"GMT":               "Atlantic/Reykjavik",
+	"Gulf":              "Asia/Dubai",
+	"Hawaii_Aleutian":   "Pacific/Honolulu",
+	"India":             "Asia/Kolkata",
+	"Indochina":         "Asia/Bangkok",
+	"Mexico_Pacific":    "America/Mazatlan",
+	"Moscow":            "Europe/Moscow",
+}
+
+// unknownTimeZone is the CLDR ID for time zones which aren't known, like
+// time.Local and fixed zones
+const unknownTimeZone = "Etc/Unknown"
+
+// timeZoneID returns the time zone database ID of a time's location, like
+// "America/Los_Angeles", with aliases resolved. It's "Etc/Unknown" for
+// locations which aren't from the time zone database, like time.Local and
+// time.FixedZone zones.
+func timeZoneID(datetime time.Time) string {
+	id := datetime.Location().String()
+	if alias, ok := timeZoneAliases[id]; ok {
+		return alias
+	}
+
+	if !strings.Contains(id, "/") {
+		return unknownTimeZone
+	}
+
+	return id
+}
+
+// timeZoneNames returns the locale's names for a time zone - the names of its
+// metazone, replaced by any names given for the zone itself
+func (t *Translator) timeZoneNames(id string) (names timeZoneNames) {
+	zoneNames := t.rules.DateTime.TimeZoneNames
+	if metazone, ok := metazones[id]; ok {
+		names = zoneNames.Metazones[metazone]
+	}
+
+	return names.merge(zoneNames.Zones[id])
+}
+
+// timeZoneExemplarCity returns the name of the city a time zone is named
+// after, like "Los Angeles" for "America/Los_Angeles". Zones without a name
+// for their city in the rules use the last part of their ID.
+func (t *Translator) timeZoneExemplarCity(id string) string {
+	if city := t.rules.DateTime.TimeZoneNames.Zones[id].ExemplarCity; city != "" {
+		return city
+	}
+
+	if id == unknownTimeZone || strings.HasPrefix(id, "Etc/") {
+		if city := t.rules.DateTime.TimeZoneNames.Zones[unknownTimeZone].ExemplarCity; city != "" {
+			return city
+		}
+		return "Unknown"
+	}
+
+	return strings.Replace(id[strings.LastIndex(id, "/")+1:], "_", " ", -1)
+}
+
+// observesDaylightTime returns whether a time's location uses daylight saving
+// time at any point in the year starting at the time
+func observesDaylightTime(datetime time.Time) bool {
+	for months := 0; months < 12; months += 3 {
+		if datetime.AddDate(0, months, 0).IsDST() {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDateTimeComponentTimeZoneSpecific renders a specific non-location time
+// zone name, which says whether daylight saving time is in effect - "PST" or
+// "PDT" for z, zz and zzz, and "Pacific Standard Time" or "Pacific Daylight
+// Time" for zzzz. Zones the locale has no names for use the GMT format.
+func (t *Translator) formatDateTimeComponentTimeZoneSpecific(datetime time.Time, length int) (string, error) {
+	if length > datetimeFormatLengthWide {
+		return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+	}
+
+	names := t.timeZoneNames(timeZoneID(datetime))
+	nameSet := names.Short
+	gmtLength := datetimeFormatLength1Plus
+	if length == datetimeFormatLengthWide {
+		nameSet = names.Long
+		gmtLength = datetimeFormatLengthWide
+	}
+
+	name := nameSet.Standard
+	if datetime.IsDST() {
+		name = nameSet.Daylight
+	}
+
+	if name != "" {
+		return name, nil
+	}
+
+	return t.formatDateTimeComponentTimeZoneGMT(datetime, gmtLength)
+}
+
+// formatDateTimeComponentTimeZoneGeneric renders a generic non-location time
+// zone name, which is the same in standard and daylight saving time - "PT" for
+// v, and "Pacific Time" for vvvv. Zones which don't use daylight saving time
+// can use their standard names. Zones the locale has no names for use the
+// location format, like "Los Angeles Time", and then the GMT format.
+func (t *Translator) formatDateTimeComponentTimeZoneGeneric(datetime time.Time, length int) (string, error) {
+	if length != datetimeFormatLength1Plus && length != datetimeFormatLengthWide {
+		return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+	}
+
+	names := t.timeZoneNames(timeZoneID(datetime))
+	nameSet := names.Short
+	if length == datetimeFormatLengthWide {
+		nameSet = names.Long
+	}
+
+	name := nameSet.Generic
+	if name == "" && !observesDaylightTime(datetime) {
+		name = nameSet.Standard
+	}
+
+	if name != "" {
+		return name, nil
+	}
+
+	if location, ok := t.timeZoneLocation(timeZoneID(datetime)); ok {
+		return location, nil
+	}
+
+	return t.formatDateTimeComponentTimeZoneGMT(datetime, length)
+}
+
+// formatDateTimeComponentTimeZoneLocation renders a time zone by its ID or
+// location - "America/Los_Angeles" for VV, "Los Angeles" for VVV and "Los
+// Angeles Time" for VVVV. Zones without a location, like UTC, use the long
+// GMT format for VVVV.
+func (t *Translator) formatDateTimeComponentTimeZoneLocation(datetime time.Time, length int) (string, error) {
+	id := timeZoneID(datetime)
+
+	switch length {
+	case datetimeFormatLength2Plus:
+		return id, nil
+	case datetimeFormatLengthAbbreviated:
+		return t.timeZoneExemplarCity(id), nil
+	case datetimeFormatLengthWide:
+		if location, ok := t.timeZoneLocation(id); ok {
+			return location, nil
+		}
+		return t.formatDateTimeComponentTimeZoneGMT(datetime, datetimeFormatLengthWide)
+	}
+
+	return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+}
+
+// timeZoneLocation returns the generic location format of a time zone, like
+// "Los Angeles Time". It returns false for zones without a location.
+func (t *Translator) timeZoneLocation(id string) (string, bool) {
+	if id == unknownTimeZone || strings.HasPrefix(id, "Etc/") {
+		return "", false
+	}
+
+	regionFormat := t.rules.DateTime.TimeZoneNames.RegionFormat
+	if regionFormat == "" {
+		regionFormat = "{0}"
+	}
+
+	return strings.Replace(regionFormat, "{0}", t.timeZoneExemplarCity(id), 1), true
+}
+
+// formatDateTimeComponentTimeZoneGMT renders the localized GMT format of a time
+// zone's offset - "GMT-8" or "GMT+5:30" for O, and "GMT-08:00" for OOOO. A zero
+// offset is rendered as just "GMT".
+func (t *Translator) formatDateTimeComponentTimeZoneGMT(datetime time.Time, length int) (string, error) {
+	if length != datetimeFormatLength1Plus && length != datetimeFormatLengthWide {
+		return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+	}
+
+	zoneNames := t.rules.DateTime.TimeZoneNames
+	_, offset := datetime.Zone()
+	if offset/60 == 0 && zoneNames.GMTZeroFormat != "" {
+		return zoneNames.GMTZeroFormat, nil
+	}
+
+	hourFormat := zoneNames.HourFormat
+	if hourFormat == "" {
+		hourFormat = "+HH:mm;-HH:mm"
+	}
+
+	pattern := hourFormat
+	if pos := strings.Index(hourFormat, ";"); pos != -1 {
+		pattern = hourFormat[:pos]
+		if offset < 0 {
+			pattern = hourFormat[pos+1:]
+		}
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+
+	hours := offset / 3600
+	minutes := offset / 60 % 60
+
+	// the short format leaves out the leading zero of the hours, and the
+	// minutes when they're zero
+	if length == datetimeFormatLength1Plus {
+		hoursEnd := strings.LastIndex(pattern, "H") + 1
+		minutesStart := strings.Index(pattern, "mm")
+		if minutes == 0 && minutesStart >= hoursEnd {
+			pattern = pattern[:hoursEnd] + pattern[minutesStart+2:]
+		}
+		pattern = strings.Replace(pattern, "HH", "H", 1)
+	}
+
+	if strings.Contains(pattern, "HH") {
+		pattern = strings.Replace(pattern, "HH", fmt.Sprintf("%02d", hours), 1)
+	} else {
+		pattern = strings.Replace(pattern, "H", fmt.Sprintf("%d", hours), 1)
+	}
+	pattern = strings.Replace(pattern, "mm", fmt.Sprintf("%02d", minutes), 1)
+
+	gmtFormat := zoneNames.GMTFormat
+	if gmtFormat == "" {
+		gmtFormat = "GMT{0}"
+	}
+
+	return strings.Replace(gmtFormat, "{0}", pattern, 1), nil
+}
+
+// formatDateTimeComponentTimeZoneISO renders an ISO 8601 time zone offset -
+// "-08", "-0800", "-08:00", "-0800" and "-08:00" for the lengths 1 to 5. The
+// first length also includes the minutes if they aren't zero, and the last two
+// include the seconds if they aren't zero. With the utcIndicator, used for X,
+// a zero offset is rendered as "Z".
+func (t *Translator) formatDateTimeComponentTimeZoneISO(datetime time.Time, length int, utcIndicator bool) (string, error) {
+	if length < datetimeFormatLength1Plus || length > datetimeFormatLengthNarrow {
+		return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+	}
+
+	_, offset := datetime.Zone()
+	if offset == 0 && utcIndicator {
+		return "Z", nil
+	}
+
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	hours := offset / 3600
+	minutes := offset / 60 % 60
+	seconds := offset % 60
+
+	separator := ""
+	if length == datetimeFormatLengthAbbreviated || length == datetimeFormatLengthNarrow {
+		separator = ":"
+	}
+
+	formatted := fmt.Sprintf("%s%02d", sign, hours)
+	if length != datetimeFormatLength1Plus || minutes != 0 {
+		formatted += fmt.Sprintf("%s%02d", separator, minutes)
+	}
+	if length >= datetimeFormatLengthWide && seconds != 0 {
+		formatted += fmt.Sprintf("%s%02d", separator, seconds)
+	}
+
+	return formatted, nil
+}
+
+// formatDateTimeComponentTimeZoneRFC renders a time zone offset in the RFC 822
+// style of Z, ZZ and ZZZ - "-0800" - in the long GMT format for ZZZZ, or in
+// the ISO 8601 format of XXXXX for ZZZZZ.
+func (t *Translator) formatDateTimeComponentTimeZoneRFC(datetime time.Time, length int) (string, error) {
+	switch length {
+	case datetimeFormatLengthWide:
+		return t.formatDateTimeComponentTimeZoneGMT(datetime, datetimeFormatLengthWide)
+	case datetimeFormatLengthNarrow:
+		return t.formatDateTimeComponentTimeZoneISO(datetime, datetimeFormatLengthNarrow, true)
+	}
+
+	if length > datetimeFormatLengthNarrow {
+		return "", translatorError{message: fmt.Sprintf("unsupported time zone: %d", length)}
+	}
+
+	formatted, err := t.formatDateTimeComponentTimeZoneISO(datetime, datetimeFormatLengthWide, false)
+	if err != nil {
+		return "", err
+	}
+
+	// the RFC 822 format doesn't include seconds
+	return formatted[:5], nil
+}
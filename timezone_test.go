@@ -0,0 +1,166 @@
+package i18n
+
+import (
+	"time"
+	_ "time/tzdata"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFormatDateTimeTimeZone(c *C) {
+	m := NewMemorySource()
+	for _, locale := range []string{"en", "en-GB", "de", "fr"} {
+		m.AddMessages(locale, map[string]interface{}{"LOCALE": locale})
+	}
+
+	f, errors := NewTranslatorFactoryFromSources([]RulesSource{NewDirSource("data/rules")}, []MessageSource{m}, "en")
+	c.Assert(errors, HasLen, 0)
+
+	translators := map[string]*Translator{}
+	for _, locale := range []string{"en", "en-GB", "de", "fr"} {
+		translators[locale], errors = f.GetTranslator(locale)
+		c.Assert(errors, HasLen, 0)
+	}
+
+	location := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		c.Assert(err, IsNil)
+		return loc
+	}
+
+	summer := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale   string
+		datetime time.Time
+		pattern  string
+		expected string
+	}{
+		// a zone in a metazone with short and long names
+		{"en", summer.In(location("America/Los_Angeles")), "z", "PDT"},
+		{"en", summer.In(location("America/Los_Angeles")), "zzz", "PDT"},
+		{"en", summer.In(location("America/Los_Angeles")), "zzzz", "Pacific Daylight Time"},
+		{"en", winter.In(location("America/Los_Angeles")), "z", "PST"},
+		{"en", winter.In(location("America/Los_Angeles")), "zzzz", "Pacific Standard Time"},
+		{"en", summer.In(location("America/Los_Angeles")), "v", "PT"},
+		{"en", summer.In(location("America/Los_Angeles")), "vvvv", "Pacific Time"},
+		{"en", summer.In(location("America/Los_Angeles")), "VV", "America/Los_Angeles"},
+		{"en", summer.In(location("America/Los_Angeles")), "VVV", "Los Angeles"},
+		{"en", summer.In(location("America/Los_Angeles")), "VVVV", "Los Angeles Time"},
+		{"en", summer.In(location("America/Los_Angeles")), "O", "GMT-7"},
+		{"en", summer.In(location("America/Los_Angeles")), "OOOO", "GMT-07:00"},
+		{"en", summer.In(location("America/Los_Angeles")), "X", "-07"},
+		{"en", summer.In(location("America/Los_Angeles")), "XX", "-0700"},
+		{"en", summer.In(location("America/Los_Angeles")), "XXX", "-07:00"},
+		{"en", summer.In(location("America/Los_Angeles")), "xxxx", "-0700"},
+		{"en", summer.In(location("America/Los_Angeles")), "Z", "-0700"},
+		{"en", summer.In(location("America/Los_Angeles")), "ZZZZ", "GMT-07:00"},
+		{"en", summer.In(location("America/Los_Angeles")), "ZZZZZ", "-07:00"},
+
+		// aliases use the names of the zone they're an alias for
+		{"en", summer.In(location("US/Pacific")), "z", "PDT"},
+		{"en", summer.In(location("Asia/Calcutta")), "VV", "Asia/Kolkata"},
+
+		// missing names fall back to the GMT and location formats
+		{"en", summer.In(location("Asia/Kolkata")), "z", "GMT+5:30"},
+		{"en", summer.In(location("Asia/Kolkata")), "zzzz", "India Standard Time"},
+		{"en", summer.In(location("Asia/Kolkata")), "v", "Kolkata Time"},
+		{"en", summer.In(location("Asia/Kolkata")), "vvvv", "India Standard Time"},
+		{"en", summer.In(location("Asia/Kolkata")), "O", "GMT+5:30"},
+		{"en", summer.In(location("Asia/Kolkata")), "OOOO", "GMT+05:30"},
+		{"en", summer.In(location("Asia/Kolkata")), "X", "+0530"},
+		{"en", summer.In(location("Europe/Berlin")), "z", "GMT+2"},
+		{"en", summer.In(location("Europe/Berlin")), "v", "Berlin Time"},
+		{"en", summer.In(location("Europe/Berlin")), "vvvv", "Central European Time"},
+		{"en", summer.In(location("Europe/London")), "z", "GMT+1"},
+		{"en", summer.In(location("Europe/London")), "zzzz", "British Summer Time"},
+		{"en", winter.In(location("Europe/London")), "z", "GMT"},
+		{"en", winter.In(location("Europe/London")), "zzzz", "Greenwich Mean Time"},
+		{"en", winter.In(location("Europe/London")), "v", "London Time"},
+		{"en", summer.In(location("Pacific/Honolulu")), "v", "HST"},
+		{"en", summer.In(location("Pacific/Honolulu")), "z", "HST"},
+		{"en", summer.In(location("Asia/Ho_Chi_Minh")), "VVV", "Ho Chi Minh City"},
+
+		// zones without a location
+		{"en", summer, "z", "UTC"},
+		{"en", summer, "zzzz", "Coordinated Universal Time"},
+		{"en", summer, "v", "UTC"},
+		{"en", summer, "VV", "Etc/UTC"},
+		{"en", summer, "VVV", "Unknown City"},
+		{"en", summer, "VVVV", "GMT"},
+		{"en", summer, "O", "GMT"},
+		{"en", summer, "X", "Z"},
+		{"en", summer, "XXX", "Z"},
+		{"en", summer, "x", "+00"},
+		{"en", summer, "xxx", "+00:00"},
+		{"en", summer, "Z", "+0000"},
+		{"en", summer.In(time.FixedZone("", -(3*3600 + 30*60))), "z", "GMT-3:30"},
+		{"en", summer.In(time.FixedZone("", -(3*3600 + 30*60))), "v", "GMT-3:30"},
+		{"en", summer.In(time.FixedZone("", -(3*3600 + 30*60))), "VV", "Etc/Unknown"},
+		{"en", summer.In(time.FixedZone("LMT", 5*3600+21*60+10)), "X", "+0521"},
+		{"en", summer.In(time.FixedZone("LMT", 5*3600+21*60+10)), "XXXX", "+052110"},
+		{"en", summer.In(time.FixedZone("LMT", 5*3600+21*60+10)), "XXXXX", "+05:21:10"},
+		{"en", summer.In(time.FixedZone("LMT", 5*3600+21*60+10)), "Z", "+0521"},
+
+		// locale names, with names from less specific locales
+		{"en-GB", summer.In(location("Europe/Berlin")), "z", "CEST"},
+		{"en-GB", summer.In(location("Europe/Berlin")), "zzzz", "Central European Summer Time"},
+		{"de", summer.In(location("Europe/Berlin")), "z", "MESZ"},
+		{"de", winter.In(location("Europe/Berlin")), "zzzz", "Mitteleuropäische Normalzeit"},
+		{"de", summer.In(location("Europe/Vienna")), "vvvv", "Mitteleuropäische Zeit"},
+		{"de", summer.In(location("Europe/Vienna")), "VVVV", "Wien (Ortszeit)"},
+		{"fr", summer.In(location("Europe/Paris")), "z", "UTC+2"},
+		{"fr", summer.In(location("Europe/Paris")), "zzzz", "heure d’été d’Europe centrale"},
+		{"fr", winter.In(location("America/New_York")), "OOOO", "UTC−05:00"},
+		{"fr", summer.In(location("Europe/London")), "VVVV", "heure : Londres"},
+		{"fr", summer, "O", "UTC"},
+	}
+
+	for _, test := range tests {
+		comment := Commentf("%s %s %s", test.locale, test.datetime.Location(), test.pattern)
+		str, err := translators[test.locale].formatDateTimeComponent(test.datetime, test.pattern)
+		c.Check(err, IsNil, comment)
+		c.Check(str, Equals, test.expected, comment)
+	}
+
+	for _, pattern := range []string{"zzzzz", "vv", "vvvvv", "V", "VVVVV", "OO", "OOOOO", "XXXXXX", "xxxxxx", "ZZZZZZ"} {
+		_, err := translators["en"].formatDateTimeComponent(summer, pattern)
+		c.Check(err, NotNil, Commentf(pattern))
+	}
+
+	str, err := translators["en"].FormatDateTime(TimeFormatFull, summer.In(location("America/Los_Angeles")))
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "5:00:00 AM Pacific Daylight Time")
+
+	str, err = translators["fr"].FormatDateTime(TimeFormatLong, winter.In(location("Europe/Paris")))
+	c.Check(err, IsNil)
+	c.Check(str, Equals, "13:00:00 UTC+1")
+}
+
+func (s *MySuite) TestMergeTimeZoneNames(c *C) {
+	names := map[string]timeZoneNames{
+		"Europe/London": {ExemplarCity: "London"},
+	}
+	newNames := map[string]timeZoneNames{
+		"Europe/London": {Long: timeZoneNameSet{Daylight: "British Summer Time"}},
+		"Europe/Paris":  {ExemplarCity: "Paris"},
+	}
+
+	merged := mergeTimeZoneNames(names, newNames)
+	c.Check(merged, DeepEquals, map[string]timeZoneNames{
+		"Europe/London": {ExemplarCity: "London", Long: timeZoneNameSet{Daylight: "British Summer Time"}},
+		"Europe/Paris":  {ExemplarCity: "Paris"},
+	})
+
+	// the maps being merged aren't changed
+	c.Check(names, HasLen, 1)
+	c.Check(names["Europe/London"].Long.Daylight, Equals, "")
+	c.Check(mergeTimeZoneNames(names, nil), DeepEquals, names)
+}
+
+func (s *MySuite) TestMetazoneGoldenZones(c *C) {
+	for metazone, id := range metazoneGoldenZones {
+		c.Check(metazones[id], Equals, metazone, Commentf(id))
+	}
+}
@@ -0,0 +1,642 @@
+package i18n
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// XLIFF versions supported by ReadXLIFF and XLIFFDocument.Write
+const (
+	XLIFF12 = "1.2"
+	XLIFF20 = "2.0"
+)
+
+const (
+	xliff12Namespace = "urn:oasis:names:tc:xliff:document:1.2"
+	xliff20Namespace = "urn:oasis:names:tc:xliff:document:2.0"
+
+	// group types for messages with a context and messages with variants. XLIFF
+	// 1.2 uses them as a group's restype, and XLIFF 2.0 as a group's type.
+	xliff12ContextGroup  = "x-i18n-context"
+	xliff12VariantsGroup = "x-i18n-variants"
+	xliff20ContextGroup  = "i18n:context"
+	xliff20VariantsGroup = "i18n:variants"
+)
+
+// XLIFFDocument is a translation catalog for a source and a target locale,
+// which can be written to and read from XLIFF 1.2 and 2.0 files for use with
+// CAT tools
+type XLIFFDocument struct {
+	Version      string
+	SourceLocale string
+	TargetLocale string
+	Units        []XLIFFUnit
+}
+
+// XLIFFUnit is a single message in an XLIFF document. Messages with named
+// variants, like plural messages, have a unit for each variant, and messages
+// with a context have the context they're translated in. The state is the
+// translation state used by the document's XLIFF version, like "translated".
+type XLIFFUnit struct {
+	Key     string
+	Context string
+	Variant string
+	Source  string
+	Target  string
+	State   string
+	Notes   []string
+}
+
+// NewXLIFFDocument returns an XLIFF document with a unit for each of the
+// source translator's messages, and the target translator's translation of
+// each message, if it has one. Plural messages have a unit for each of the
+// target locale's plural categories, since those are the variants a translator
+// needs to fill in. Messages from fallback translators aren't included.
+func NewXLIFFDocument(source *Translator, target *Translator) *XLIFFDocument {
+	d := &XLIFFDocument{
+		Version:      XLIFF20,
+		SourceLocale: source.locale,
+		TargetLocale: target.locale,
+	}
+
+	keys := make([]string, 0, len(source.messages)+len(source.variants))
+	for key := range source.messages {
+		// the variants of nested messages are also stored with dotted keys,
+		// but they're exported with the rest of their message's variants
+		if pos := strings.LastIndex(key, "."); pos != -1 {
+			if _, ok := source.variants[key[:pos]][key[pos+1:]]; ok {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	for key := range source.variants {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		unit := XLIFFUnit{Key: key}
+		if pos := strings.Index(key, messageContextSeparator); pos != -1 {
+			unit.Context = key[:pos]
+			unit.Key = key[pos+len(messageContextSeparator):]
+		}
+
+		if message, ok := source.messages[key]; ok {
+			unit.Source = message
+			unit.Target = target.messages[key]
+			d.addUnit(unit)
+			continue
+		}
+
+		sourceVariants := source.variants[key]
+		targetVariants := target.variants[key]
+
+		var names []string
+		if isPluralVariants(sourceVariants) && target.rules != nil {
+			for _, category := range target.rules.PluralCategories {
+				names = append(names, category.String())
+			}
+		} else {
+			for name := range sourceVariants {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+		}
+
+		for _, name := range names {
+			unit.Variant = name
+			unit.Source = sourceVariants[name]
+			if _, ok := sourceVariants[name]; !ok {
+				unit.Source = sourceVariants[pluralCategoryOther.String()]
+			}
+			unit.Target = targetVariants[name]
+			d.addUnit(unit)
+		}
+	}
+
+	return d
+}
+
+// addUnit adds a unit exported from a translator, with the state for whether
+// it has been translated
+func (d *XLIFFDocument) addUnit(unit XLIFFUnit) {
+	unit.State = "initial"
+	if unit.Target != "" {
+		unit.State = "translated"
+	}
+	d.Units = append(d.Units, unit)
+}
+
+// isPluralVariants returns whether the names of a message's variants are all
+// plural categories
+func isPluralVariants(variants map[string]string) bool {
+	for name := range variants {
+		if _, ok := pluralCategoryFromName(name); !ok {
+			return false
+		}
+	}
+	return len(variants) > 0
+}
+
+// ExportXLIFF writes an XLIFF document with the source translator's messages
+// and the target translator's translations of them. The version is either
+// XLIFF12 or XLIFF20.
+func ExportXLIFF(w io.Writer, source *Translator, target *Translator, version string) error {
+	d := NewXLIFFDocument(source, target)
+	d.Version = version
+	return d.Write(w)
+}
+
+// Write writes the document as an XLIFF file, using the document's version.
+// Unit states are converted to the closest state of that version.
+func (d *XLIFFDocument) Write(w io.Writer) (err error) {
+	var doc interface{}
+
+	switch d.Version {
+	case XLIFF12:
+		doc = d.xliff12()
+	case XLIFF20, "":
+		doc = d.xliff20()
+	default:
+		return translatorError{message: "unsupported XLIFF version: " + d.Version}
+	}
+
+	_, err = io.WriteString(w, xml.Header)
+	if err != nil {
+		return
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(doc)
+	if err != nil {
+		return
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return
+}
+
+// ReadXLIFF reads an XLIFF 1.2 or 2.0 file. Inline markup in sources and
+// targets isn't supported - only their text is kept.
+func ReadXLIFF(r io.Reader) (d *XLIFFDocument, err error) {
+	var root struct {
+		XMLName xml.Name
+		Version string `xml:"version,attr"`
+	}
+
+	contents, err := ioutil.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal(contents, &root)
+	if err != nil {
+		err = translatorError{message: "can't read XLIFF: " + err.Error()}
+		return
+	}
+
+	switch {
+	case root.XMLName.Local != "xliff":
+		err = translatorError{message: "can't read XLIFF: unexpected root element " + root.XMLName.Local}
+	case root.Version == XLIFF12 || root.XMLName.Space == xliff12Namespace:
+		var doc xliff12
+		err = xml.Unmarshal(contents, &doc)
+		if err == nil {
+			d = doc.document()
+		}
+	case root.Version == XLIFF20 || root.XMLName.Space == xliff20Namespace:
+		var doc xliff20
+		err = xml.Unmarshal(contents, &doc)
+		if err == nil {
+			d = doc.document()
+		}
+	default:
+		err = translatorError{message: "unsupported XLIFF version: " + root.Version}
+	}
+
+	if _, ok := err.(translatorError); err != nil && !ok {
+		err = translatorError{message: "can't read XLIFF: " + err.Error()}
+	}
+	return
+}
+
+// WriteMessages writes the document's translations as a YAML messages file
+// the factory can load. Each message's notes and translation state are kept as
+// comments above it. Units without a target are skipped, and so are units
+// whose state says they still need translating, like "initial" or "new", so
+// that unfinished translations aren't used as if they were done.
+func (d *XLIFFDocument) WriteMessages(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+
+	var contexts []string
+	byContext := map[string][]XLIFFUnit{}
+	for _, unit := range d.Units {
+		if unit.Target == "" || untranslatedState(unit.State) {
+			continue
+		}
+		if _, ok := byContext[unit.Context]; !ok && unit.Context != "" {
+			contexts = append(contexts, unit.Context)
+		}
+		byContext[unit.Context] = append(byContext[unit.Context], unit)
+	}
+
+	writeYAMLUnits(buf, byContext[""], "")
+
+	if len(contexts) > 0 {
+		buf.WriteString(messageContexts + ":\n")
+		for _, context := range contexts {
+			buf.WriteString("  " + strconv.Quote(context) + ":\n")
+			writeYAMLUnits(buf, byContext[context], "    ")
+		}
+	}
+
+	return buf.Flush()
+}
+
+// writeYAMLUnits writes units as YAML messages, with the variants of a message
+// written as a map
+func writeYAMLUnits(w *bufio.Writer, units []XLIFFUnit, indent string) {
+	written := map[string]bool{}
+
+	for i, unit := range units {
+		if unit.Variant == "" {
+			writeYAMLComments(w, unit, indent)
+			w.WriteString(indent + strconv.Quote(unit.Key) + ": " + strconv.Quote(unit.Target) + "\n")
+			continue
+		}
+
+		if written[unit.Key] {
+			continue
+		}
+		written[unit.Key] = true
+
+		w.WriteString(indent + strconv.Quote(unit.Key) + ":\n")
+		for _, variant := range units[i:] {
+			if variant.Key == unit.Key && variant.Variant != "" {
+				writeYAMLComments(w, variant, indent+"  ")
+				w.WriteString(indent + "  " + strconv.Quote(variant.Variant) + ": " + strconv.Quote(variant.Target) + "\n")
+			}
+		}
+	}
+}
+
+// writeYAMLComments writes a unit's notes and state as YAML comments
+func writeYAMLComments(w *bufio.Writer, unit XLIFFUnit, indent string) {
+	for _, note := range unit.Notes {
+		for _, line := range strings.Split(note, "\n") {
+			w.WriteString(indent + "# " + line + "\n")
+		}
+	}
+	if unit.State != "" {
+		w.WriteString(indent + "# state: " + unit.State + "\n")
+	}
+}
+
+// untranslatedState returns whether a translation state, of either XLIFF
+// version, is for a target which hasn't been translated yet
+func untranslatedState(state string) bool {
+	switch state {
+	case "initial", "new", "needs-translation":
+		return true
+	}
+	return false
+}
+
+// xliffState converts a translation state to the closest state of an XLIFF
+// version
+func xliffState(state string, version string) string {
+	if version == XLIFF12 {
+		switch state {
+		case "initial":
+			return "new"
+		case "reviewed":
+			return "signed-off"
+		}
+		return state
+	}
+
+	switch state {
+	case "", "initial", "translated", "reviewed", "final":
+		return state
+	case "new", "needs-translation":
+		return "initial"
+	case "signed-off":
+		return "reviewed"
+	}
+	return "translated"
+}
+
+// xliffNode is a unit, or a group of units for a context or for the variants
+// of a message, in an XLIFF file
+type xliffNode struct {
+	unit      *XLIFFUnit
+	name      string
+	isContext bool
+	children  []*xliffNode
+}
+
+// xliffTree groups units by context, and the variants of each message
+func xliffTree(units []XLIFFUnit) (nodes []*xliffNode) {
+	contexts := map[string]*xliffNode{}
+	variants := map[string]*xliffNode{}
+
+	for i := range units {
+		unit := &units[i]
+
+		parent := &nodes
+		if unit.Context != "" {
+			context, ok := contexts[unit.Context]
+			if !ok {
+				context = &xliffNode{name: unit.Context, isContext: true}
+				contexts[unit.Context] = context
+				nodes = append(nodes, context)
+			}
+			parent = &context.children
+		}
+
+		if unit.Variant == "" {
+			*parent = append(*parent, &xliffNode{unit: unit, name: unit.Key})
+			continue
+		}
+
+		key := contextKey(unit.Context, unit.Key)
+		group, ok := variants[key]
+		if !ok {
+			group = &xliffNode{name: unit.Key}
+			variants[key] = group
+			*parent = append(*parent, group)
+		}
+		group.children = append(group.children, &xliffNode{unit: unit, name: unit.Variant})
+	}
+
+	return
+}
+
+// xliff12 is an XLIFF 1.2 file
+type xliff12 struct {
+	XMLName xml.Name      `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string        `xml:"version,attr"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	Original       string       `xml:"original,attr"`
+	SourceLanguage string       `xml:"source-language,attr"`
+	TargetLanguage string       `xml:"target-language,attr,omitempty"`
+	Datatype       string       `xml:"datatype,attr"`
+	Body           xliff12Group `xml:"body"`
+}
+
+type xliff12Group struct {
+	ID      string         `xml:"id,attr,omitempty"`
+	Resname string         `xml:"resname,attr,omitempty"`
+	Restype string         `xml:"restype,attr,omitempty"`
+	Units   []xliff12Unit  `xml:"trans-unit"`
+	Groups  []xliff12Group `xml:"group"`
+}
+
+type xliff12Unit struct {
+	ID      string         `xml:"id,attr"`
+	Resname string         `xml:"resname,attr,omitempty"`
+	Source  string         `xml:"source"`
+	Target  *xliff12Target `xml:"target"`
+	Notes   []string       `xml:"note"`
+}
+
+type xliff12Target struct {
+	State string `xml:"state,attr,omitempty"`
+	Text  string `xml:",chardata"`
+}
+
+// xliff12 returns the document as an XLIFF 1.2 file
+func (d *XLIFFDocument) xliff12() *xliff12 {
+	units := 0
+	groups := 0
+
+	var add func(group *xliff12Group, nodes []*xliffNode)
+	add = func(group *xliff12Group, nodes []*xliffNode) {
+		for _, node := range nodes {
+			if node.unit == nil {
+				groups++
+				child := xliff12Group{ID: "g" + strconv.Itoa(groups), Resname: node.name, Restype: xliff12VariantsGroup}
+				if node.isContext {
+					child.Restype = xliff12ContextGroup
+				}
+				add(&child, node.children)
+				group.Groups = append(group.Groups, child)
+				continue
+			}
+
+			units++
+			unit := xliff12Unit{
+				ID:      "u" + strconv.Itoa(units),
+				Resname: node.name,
+				Source:  node.unit.Source,
+				Notes:   node.unit.Notes,
+			}
+			if node.unit.Target != "" || node.unit.State != "" {
+				unit.Target = &xliff12Target{State: xliffState(node.unit.State, XLIFF12), Text: node.unit.Target}
+			}
+			group.Units = append(group.Units, unit)
+		}
+	}
+
+	file := xliff12File{
+		Original:       "messages",
+		SourceLanguage: d.SourceLocale,
+		TargetLanguage: d.TargetLocale,
+		Datatype:       "plaintext",
+	}
+	add(&file.Body, xliffTree(d.Units))
+
+	return &xliff12{Version: XLIFF12, Files: []xliff12File{file}}
+}
+
+// document returns the XLIFF 1.2 file's units as a document
+func (x *xliff12) document() *XLIFFDocument {
+	d := &XLIFFDocument{Version: XLIFF12}
+
+	var add func(group xliff12Group, context string, key string)
+	add = func(group xliff12Group, context string, key string) {
+		for _, u := range group.Units {
+			unit := XLIFFUnit{Key: xliffName(u.Resname, u.ID), Context: context, Source: u.Source, Notes: u.Notes}
+			if key != "" {
+				unit.Key = key
+				unit.Variant = xliffName(u.Resname, u.ID)
+			}
+			if u.Target != nil {
+				unit.Target = u.Target.Text
+				unit.State = u.Target.State
+			}
+			d.Units = append(d.Units, unit)
+		}
+
+		for _, g := range group.Groups {
+			switch g.Restype {
+			case xliff12ContextGroup:
+				add(g, g.Resname, "")
+			case xliff12VariantsGroup, "x-gettext-plurals":
+				add(g, context, xliffName(g.Resname, g.ID))
+			default:
+				add(g, context, key)
+			}
+		}
+	}
+
+	for _, file := range x.Files {
+		if d.SourceLocale == "" {
+			d.SourceLocale = file.SourceLanguage
+			d.TargetLocale = file.TargetLanguage
+		}
+		add(file.Body, "", "")
+	}
+
+	return d
+}
+
+// xliff20 is an XLIFF 2.0 file
+type xliff20 struct {
+	XMLName xml.Name      `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr,omitempty"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID     string         `xml:"id,attr"`
+	Units  []xliff20Unit  `xml:"unit"`
+	Groups []xliff20Group `xml:"group"`
+}
+
+type xliff20Group struct {
+	ID     string         `xml:"id,attr"`
+	Name   string         `xml:"name,attr,omitempty"`
+	Type   string         `xml:"type,attr,omitempty"`
+	Units  []xliff20Unit  `xml:"unit"`
+	Groups []xliff20Group `xml:"group"`
+}
+
+type xliff20Unit struct {
+	ID       string           `xml:"id,attr"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Notes    *xliff20Notes    `xml:"notes"`
+	Segments []xliff20Segment `xml:"segment"`
+}
+
+type xliff20Notes struct {
+	Notes []string `xml:"note"`
+}
+
+type xliff20Segment struct {
+	State  string `xml:"state,attr,omitempty"`
+	Source string `xml:"source"`
+	Target string `xml:"target,omitempty"`
+}
+
+// xliff20 returns the document as an XLIFF 2.0 file
+func (d *XLIFFDocument) xliff20() *xliff20 {
+	units := 0
+	groups := 0
+
+	var add func(group *xliff20Group, nodes []*xliffNode)
+	add = func(group *xliff20Group, nodes []*xliffNode) {
+		for _, node := range nodes {
+			if node.unit == nil {
+				groups++
+				child := xliff20Group{ID: "g" + strconv.Itoa(groups), Name: node.name, Type: xliff20VariantsGroup}
+				if node.isContext {
+					child.Type = xliff20ContextGroup
+				}
+				add(&child, node.children)
+				group.Groups = append(group.Groups, child)
+				continue
+			}
+
+			units++
+			unit := xliff20Unit{
+				ID:   "u" + strconv.Itoa(units),
+				Name: node.name,
+				Segments: []xliff20Segment{{
+					State:  xliffState(node.unit.State, XLIFF20),
+					Source: node.unit.Source,
+					Target: node.unit.Target,
+				}},
+			}
+			if len(node.unit.Notes) > 0 {
+				unit.Notes = &xliff20Notes{Notes: node.unit.Notes}
+			}
+			group.Units = append(group.Units, unit)
+		}
+	}
+
+	root := xliff20Group{}
+	add(&root, xliffTree(d.Units))
+
+	return &xliff20{
+		Version: XLIFF20,
+		SrcLang: d.SourceLocale,
+		TrgLang: d.TargetLocale,
+		Files:   []xliff20File{{ID: "messages", Units: root.Units, Groups: root.Groups}},
+	}
+}
+
+// document returns the XLIFF 2.0 file's units as a document. The segments of
+// a unit are joined into a single message.
+func (x *xliff20) document() *XLIFFDocument {
+	d := &XLIFFDocument{Version: XLIFF20, SourceLocale: x.SrcLang, TargetLocale: x.TrgLang}
+
+	var add func(group xliff20Group, context string, key string)
+	add = func(group xliff20Group, context string, key string) {
+		for _, u := range group.Units {
+			unit := XLIFFUnit{Key: xliffName(u.Name, u.ID), Context: context}
+			if u.Notes != nil {
+				unit.Notes = u.Notes.Notes
+			}
+			if key != "" {
+				unit.Key = key
+				unit.Variant = xliffName(u.Name, u.ID)
+			}
+			for _, segment := range u.Segments {
+				unit.Source += segment.Source
+				unit.Target += segment.Target
+				if unit.State == "" {
+					unit.State = segment.State
+				}
+			}
+			d.Units = append(d.Units, unit)
+		}
+
+		for _, g := range group.Groups {
+			switch g.Type {
+			case xliff20ContextGroup:
+				add(g, g.Name, "")
+			case xliff20VariantsGroup:
+				add(g, context, xliffName(g.Name, g.ID))
+			default:
+				add(g, context, key)
+			}
+		}
+	}
+
+	for _, file := range x.Files {
+		add(xliff20Group{Units: file.Units, Groups: file.Groups}, "", "")
+	}
+
+	return d
+}
+
+// xliffName returns a unit or group's name, or its id if it doesn't have one
+func xliffName(name string, id string) string {
+	if name != "" {
+		return name
+	}
+	return id
+}
@@ -0,0 +1,202 @@
+package i18n
+
+import (
+	"bytes"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+var xliff12Fixture = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file original="messages" source-language="en" target-language="fr" datatype="plaintext">
+    <body>
+      <trans-unit id="1" resname="WELCOME">
+        <source>Welcome!</source>
+        <target state="final">Bienvenue !</target>
+        <note>Shown on the home page</note>
+      </trans-unit>
+      <trans-unit id="2" resname="GOODBYE">
+        <source>Goodbye!</source>
+        <target state="new"></target>
+      </trans-unit>
+      <group id="3" resname="ITEM_COUNT" restype="x-gettext-plurals">
+        <trans-unit id="4" resname="one">
+          <source>{n} item</source>
+          <target state="needs-review-translation">{n} article</target>
+        </trans-unit>
+        <trans-unit id="5" resname="other">
+          <source>{n} items</source>
+          <target>{n} articles</target>
+        </trans-unit>
+      </group>
+      <group id="6" resname="store" restype="x-i18n-context">
+        <trans-unit id="7" resname="OPEN">
+          <source>Open now</source>
+          <target state="translated">Ouvert</target>
+        </trans-unit>
+      </group>
+    </body>
+  </file>
+</xliff>
+`
+
+func (s *MySuite) TestNewXLIFFDocument(c *C) {
+	source := &Translator{
+		locale: "en",
+		messages: map[string]string{
+			"WELCOME":        "Welcome!",
+			"GOODBYE":        "Goodbye!",
+			"store\x04OPEN":  "Open now",
+			"ITEM_COUNT.one": "{n} item",
+		},
+		variants: map[string]map[string]string{
+			"ITEM_COUNT": {"one": "{n} item", "other": "{n} items"},
+			"GREETING":   {"female": "Dear Ms. {name}", "male": "Dear Mr. {name}"},
+		},
+		rules: &TranslatorRules{PluralCategories: []pluralCategory{pluralCategoryOne, pluralCategoryOther}},
+	}
+
+	target := &Translator{
+		locale: "ru",
+		messages: map[string]string{
+			"WELCOME": "Добро пожаловать!",
+		},
+		variants: map[string]map[string]string{
+			"ITEM_COUNT": {"one": "{n} товар", "few": "{n} товара"},
+		},
+		rules: &TranslatorRules{PluralCategories: []pluralCategory{pluralCategoryOne, pluralCategoryFew, pluralCategoryMany, pluralCategoryOther}},
+	}
+
+	d := NewXLIFFDocument(source, target)
+	c.Check(d.Version, Equals, XLIFF20)
+	c.Check(d.SourceLocale, Equals, "en")
+	c.Check(d.TargetLocale, Equals, "ru")
+
+	c.Check(d.Units, DeepEquals, []XLIFFUnit{
+		{Key: "GOODBYE", Source: "Goodbye!", State: "initial"},
+		{Key: "GREETING", Variant: "female", Source: "Dear Ms. {name}", State: "initial"},
+		{Key: "GREETING", Variant: "male", Source: "Dear Mr. {name}", State: "initial"},
+		{Key: "ITEM_COUNT", Variant: "one", Source: "{n} item", Target: "{n} товар", State: "translated"},
+		{Key: "ITEM_COUNT", Variant: "few", Source: "{n} items", Target: "{n} товара", State: "translated"},
+		{Key: "ITEM_COUNT", Variant: "many", Source: "{n} items", State: "initial"},
+		{Key: "ITEM_COUNT", Variant: "other", Source: "{n} items", State: "initial"},
+		{Key: "WELCOME", Source: "Welcome!", Target: "Добро пожаловать!", State: "translated"},
+		{Key: "OPEN", Context: "store", Source: "Open now", State: "initial"},
+	})
+
+	// round trip through both versions
+	for _, version := range []string{XLIFF12, XLIFF20} {
+		var buf bytes.Buffer
+		err := ExportXLIFF(&buf, source, target, version)
+		c.Assert(err, IsNil)
+
+		read, err := ReadXLIFF(&buf)
+		c.Assert(err, IsNil)
+		c.Check(read.Version, Equals, version)
+		c.Check(read.SourceLocale, Equals, "en")
+		c.Check(read.TargetLocale, Equals, "ru")
+		c.Assert(read.Units, HasLen, len(d.Units))
+
+		// messages are written before groups, so they aren't read back in
+		// the same order
+		units := map[string]XLIFFUnit{}
+		for _, unit := range read.Units {
+			unit.State = xliffState(unit.State, XLIFF20)
+			units[contextKey(unit.Context, unit.Key)+"/"+unit.Variant] = unit
+		}
+		for _, unit := range d.Units {
+			c.Check(units[contextKey(unit.Context, unit.Key)+"/"+unit.Variant], DeepEquals, unit, Commentf(version))
+		}
+	}
+
+	err := ExportXLIFF(&bytes.Buffer{}, source, target, "3.0")
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestReadXLIFF(c *C) {
+	d, err := ReadXLIFF(strings.NewReader(xliff12Fixture))
+	c.Assert(err, IsNil)
+	c.Check(d.Version, Equals, XLIFF12)
+	c.Check(d.SourceLocale, Equals, "en")
+	c.Check(d.TargetLocale, Equals, "fr")
+
+	c.Check(d.Units, DeepEquals, []XLIFFUnit{
+		{Key: "WELCOME", Source: "Welcome!", Target: "Bienvenue !", State: "final", Notes: []string{"Shown on the home page"}},
+		{Key: "GOODBYE", Source: "Goodbye!", State: "new"},
+		{Key: "ITEM_COUNT", Variant: "one", Source: "{n} item", Target: "{n} article", State: "needs-review-translation"},
+		{Key: "ITEM_COUNT", Variant: "other", Source: "{n} items", Target: "{n} articles"},
+		{Key: "OPEN", Context: "store", Source: "Open now", Target: "Ouvert", State: "translated"},
+	})
+
+	// notes and states are kept when converting to 2.0
+	d.Version = XLIFF20
+	var buf bytes.Buffer
+	err = d.Write(&buf)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(buf.String(), `<note>Shown on the home page</note>`), Equals, true)
+	c.Check(strings.Contains(buf.String(), `<segment state="final">`), Equals, true)
+
+	converted, err := ReadXLIFF(&buf)
+	c.Assert(err, IsNil)
+	c.Check(converted.Units[0].Notes, DeepEquals, []string{"Shown on the home page"})
+	c.Check(converted.Units[1].State, Equals, "initial")
+	c.Check(converted.Units[2].State, Equals, "translated")
+
+	// invalid files
+	_, err = ReadXLIFF(strings.NewReader(`<xliff version="1.2"><file>`))
+	c.Check(err, NotNil)
+
+	_, err = ReadXLIFF(strings.NewReader(`<tmx version="1.4"></tmx>`))
+	c.Check(err, NotNil)
+
+	_, err = ReadXLIFF(strings.NewReader(`<xliff version="3.0"></xliff>`))
+	c.Check(err, NotNil)
+}
+
+func (s *MySuite) TestXLIFFWriteMessages(c *C) {
+	d, err := ReadXLIFF(strings.NewReader(xliff12Fixture))
+	c.Assert(err, IsNil)
+
+	var buf bytes.Buffer
+	err = d.WriteMessages(&buf)
+	c.Assert(err, IsNil)
+
+	c.Check(buf.String(), Equals, `# Shown on the home page
+# state: final
+"WELCOME": "Bienvenue !"
+"ITEM_COUNT":
+  # state: needs-review-translation
+  "one": "{n} article"
+  "other": "{n} articles"
+_context:
+  "store":
+    # state: translated
+    "OPEN": "Ouvert"
+`)
+
+	messages := map[string]string{}
+	variants := map[string]map[string]string{}
+	err = parseMessagesYAML(buf.Bytes(), messages, variants)
+	c.Assert(err, IsNil)
+	c.Check(messages["WELCOME"], Equals, "Bienvenue !")
+	c.Check(messages["store\x04OPEN"], Equals, "Ouvert")
+	c.Check(variants["ITEM_COUNT"], DeepEquals, map[string]string{"one": "{n} article", "other": "{n} articles"})
+
+	// targets which still need translating aren't written
+	d = &XLIFFDocument{Units: []XLIFFUnit{
+		{Key: "A", Target: "A fr", State: "initial"},
+		{Key: "B", Target: "B fr", State: "new"},
+		{Key: "C", Target: "C fr", State: "needs-translation"},
+		{Key: "D", Target: "D fr", State: "needs-review-translation"},
+		{Key: "E", Target: "E fr"},
+	}}
+
+	buf.Reset()
+	err = d.WriteMessages(&buf)
+	c.Assert(err, IsNil)
+	c.Check(buf.String(), Equals, `# state: needs-review-translation
+"D": "D fr"
+"E": "E fr"
+`)
+}